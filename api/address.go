@@ -10,10 +10,11 @@ type AddressResponse struct {
 }
 
 func Address(address types.Address, height int) (*AddressResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
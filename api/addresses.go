@@ -11,10 +11,11 @@ type AddressesResponse struct {
 }
 
 func Addresses(addresses []types.Address, height int) (*[]AddressesResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
@@ -3,7 +3,9 @@ package api
 import (
 	"fmt"
 	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/api/limiter"
 	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/health"
 	"github.com/MinterTeam/minter-go-node/core/minter"
 	"github.com/MinterTeam/minter-go-node/core/state"
 	"github.com/MinterTeam/minter-go-node/rpc/lib/server"
@@ -20,39 +22,45 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
 var (
-	cdc        = amino.NewCodec()
-	blockchain *minter.Blockchain
-	client     *rpc.Local
-	minterCfg  *config.Config
+	cdc           = amino.NewCodec()
+	registerCodec sync.Once
+	blockchain    *minter.Blockchain
+	client        *rpc.Local
+	minterCfg     *config.Config
 )
 
 var Routes = map[string]*rpcserver.RPCFunc{
 	"status":                 rpcserver.NewRPCFunc(Status, ""),
-	"candidates":             rpcserver.NewRPCFunc(Candidates, "height,include_stakes"),
-	"candidate":              rpcserver.NewRPCFunc(Candidate, "pub_key,height"),
-	"validators":             rpcserver.NewRPCFunc(Validators, "height,page,perPage"),
-	"address":                rpcserver.NewRPCFunc(Address, "address,height"),
-	"addresses":              rpcserver.NewRPCFunc(Addresses, "addresses,height"),
+	"candidates":             cachedRPCFunc("candidates", Candidates, "height,include_stakes"),
+	"candidate":              cachedRPCFunc("candidate", Candidate, "pub_key,height"),
+	"validators":             cachedRPCFunc("validators", Validators, "height,page,perPage"),
+	"address":                cachedRPCFunc("address", Address, "address,height"),
+	"addresses":              cachedRPCFunc("addresses", Addresses, "addresses,height"),
 	"send_transaction":       rpcserver.NewRPCFunc(SendTransaction, "tx"),
 	"transaction":            rpcserver.NewRPCFunc(Transaction, "hash"),
 	"transactions":           rpcserver.NewRPCFunc(Transactions, "query,page,perPage"),
-	"block":                  rpcserver.NewRPCFunc(Block, "height"),
-	"events":                 rpcserver.NewRPCFunc(Events, "height"),
+	"transactions_by_payload": rpcserver.NewRPCFunc(TransactionsByPayload, "value,field,prefix,page,perPage"),
+	"block":                  cachedRPCFunc("block", Block, "height"),
+	"events":                 cachedRPCFunc("events", Events, "height"),
+	"query_events":           rpcserver.NewRPCFunc(QueryEvents, "from_height,to_height,type,address,validator,coin,role,group_by,page,perPage"),
 	"net_info":               rpcserver.NewRPCFunc(NetInfo, ""),
-	"coin_info":              rpcserver.NewRPCFunc(CoinInfo, "symbol,height"),
-	"estimate_coin_sell":     rpcserver.NewRPCFunc(EstimateCoinSell, "coin_to_sell,coin_to_buy,value_to_sell,height"),
-	"estimate_coin_sell_all": rpcserver.NewRPCFunc(EstimateCoinSellAll, "coin_to_sell,coin_to_buy,value_to_sell,gas_price,height"),
-	"estimate_coin_buy":      rpcserver.NewRPCFunc(EstimateCoinBuy, "coin_to_sell,coin_to_buy,value_to_buy,height"),
-	"estimate_tx_commission": rpcserver.NewRPCFunc(EstimateTxCommission, "tx,height"),
+	"coin_info":              cachedRPCFunc("coin_info", CoinInfo, "symbol,height"),
+	"estimate_coin_sell":     cachedRPCFunc("estimate_coin_sell", EstimateCoinSell, "coin_to_sell,coin_to_buy,value_to_sell,height"),
+	"estimate_coin_sell_all": cachedRPCFunc("estimate_coin_sell_all", EstimateCoinSellAll, "coin_to_sell,coin_to_buy,value_to_sell,gas_price,height"),
+	"estimate_coin_buy":      cachedRPCFunc("estimate_coin_buy", EstimateCoinBuy, "coin_to_sell,coin_to_buy,value_to_buy,height"),
+	"estimate_tx_commission": cachedRPCFunc("estimate_tx_commission", EstimateTxCommission, "tx,height"),
+	"decode_tx":              rpcserver.NewRPCFunc(DecodeTx, "tx,height"),
+	"build_tx":               rpcserver.NewRPCFunc(BuildTx, "tx,height"),
 	"unconfirmed_txs":        rpcserver.NewRPCFunc(UnconfirmedTxs, "limit"),
-	"max_gas":                rpcserver.NewRPCFunc(MaxGas, "height"),
+	"max_gas":                cachedRPCFunc("max_gas", MaxGas, "height"),
 	"min_gas_price":          rpcserver.NewRPCFunc(MinGasPrice, ""),
 	"genesis":                rpcserver.NewRPCFunc(Genesis, ""),
-	"missed_blocks":          rpcserver.NewRPCFunc(MissedBlocks, "pub_key,height"),
+	"missed_blocks":          cachedRPCFunc("missed_blocks", MissedBlocks, "pub_key,height"),
 	"get_stakes": 		  rpcserver.NewRPCFunc(GetStakes, "pub_key,height,coin,address"),				
 	"total_slashed":      	  rpcserver.NewRPCFunc(TotalSlashed, "height"),
 	"height_by_time":      	  rpcserver.NewRPCFunc(HeightByTime, "height,query"),
@@ -69,6 +77,19 @@ var Routes = map[string]*rpcserver.RPCFunc{
 
 }
 
+type middleware func(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request)
+
+// chain composes middlewares, the first one is the outermost
+func chain(middlewares ...middleware) middleware {
+	return func(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			f = middlewares[i](f)
+		}
+
+		return f
+	}
+}
+
 func responseTime(b *minter.Blockchain) func(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
 	return func(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
 		return func(w http.ResponseWriter, r *http.Request) {
@@ -79,19 +100,10 @@ func responseTime(b *minter.Blockchain) func(f func(http.ResponseWriter, *http.R
 	}
 }
 
-func RunAPI(b *minter.Blockchain, tmRPC *rpc.Local, cfg *config.Config, logger log.Logger) {
-	minterCfg = cfg
-	RegisterCryptoAmino(cdc)
-	eventsdb.RegisterAminoEvents(cdc)
-	RegisterEvidenceMessages(cdc)
-
-	client = tmRPC
-	blockchain = b
+func RunAPI(b *minter.Blockchain, tmRPC *rpc.Local, cfg *config.Config, apiLimiter *limiter.Limiter, logger log.Logger) {
+	handler := NewHandler(b, tmRPC, cfg, apiLimiter, logger)
 	waitForTendermint()
 
-	m := http.NewServeMux()
-
-	rpcserver.RegisterRPCFuncs(m, Routes, cdc, logger.With("module", "rpc"), responseTime(b))
 	listener, err := rpcserver.Listen(cfg.APIListenAddress, rpcserver.Config{
 		MaxOpenConnections: cfg.APISimultaneousRequests,
 	})
@@ -100,14 +112,38 @@ func RunAPI(b *minter.Blockchain, tmRPC *rpc.Local, cfg *config.Config, logger l
 		panic(err)
 	}
 
+	logger.Error("Failed to start API", "err", rpcserver.StartHTTPServer(listener, handler, logger))
+}
+
+// NewHandler returns handler of API v1 serving the given blockchain. API uses package level state,
+// so handlers of different blockchains can't be used at the same time.
+func NewHandler(b *minter.Blockchain, tmRPC *rpc.Local, cfg *config.Config, apiLimiter *limiter.Limiter, logger log.Logger) http.Handler {
+	registerCodec.Do(func() {
+		RegisterCryptoAmino(cdc)
+		eventsdb.RegisterAminoEvents(cdc)
+		RegisterEvidenceMessages(cdc)
+	})
+
+	minterCfg = cfg
+	client = tmRPC
+	blockchain = b
+
+	m := http.NewServeMux()
+
+	rpcserver.RegisterRPCFuncs(m, Routes, cdc, logger.With("module", "rpc"), chain(apiLimiter.Middleware(), responseTime(b)))
+
+	// probes of orchestrators are not limited
+	checker := health.NewChecker(b, tmRPC, cfg)
+	m.HandleFunc("/health/live", healthHandler(checker.Live))
+	m.HandleFunc("/health/ready", healthHandler(checker.Ready))
+
 	c := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"POST", "GET"},
 		AllowCredentials: true,
 	})
 
-	handler := c.Handler(m)
-	logger.Error("Failed to start API", "err", rpcserver.StartHTTPServer(listener, Handler(handler), logger))
+	return Handler(c.Handler(m))
 }
 
 func Handler(h http.Handler) http.Handler {
@@ -152,14 +188,14 @@ type Response struct {
 	Log    string      `json:"log,omitempty"`
 }
 
-func GetStateForHeight(height int) (*state.State, error) {
+// GetStateForHeight returns state at height or the current one if height is 0.
+// Release should be called when the state is not used anymore.
+func GetStateForHeight(height int) (*state.State, func(), error) {
 	if height > 0 {
-		cState, err := blockchain.GetStateForHeight(uint64(height))
-
-		return cState, err
+		return blockchain.GetStateForHeight(uint64(height))
 	}
 
-	return blockchain.CurrentState(), nil
+	return blockchain.CurrentState(), func() {}, nil
 }
 
 // RegisterAmino registers all crypto related types in the given (amino) codec.
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/types"
+)
+
+type BuildTxResponse struct {
+	Tx         string `json:"tx"`
+	Hash       string `json:"hash"`
+	Nonce      uint64 `json:"nonce"`
+	Gas        int64  `json:"gas"`
+	Commission string `json:"commission"`
+}
+
+// BuildTx encodes unsigned transaction from its JSON description, see transaction.TxDescription.
+// If nonce is 0 it is taken from the state of the sender at the given height.
+// Hash is a hash which should be signed by the sender.
+func BuildTx(tx string, height int) (*BuildTxResponse, error) {
+	description := new(transaction.TxDescription)
+	if err := json.Unmarshal([]byte(tx), description); err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: "Cannot parse transaction description", Data: err.Error()}
+	}
+
+	if description.Nonce == 0 {
+		nonce, err := nextNonce(description, height)
+		if err != nil {
+			return nil, err
+		}
+		description.Nonce = nonce
+	}
+
+	builtTx, err := description.Build()
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: "Cannot build transaction", Data: err.Error()}
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(builtTx)
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 500, Message: err.Error()}
+	}
+
+	return &BuildTxResponse{
+		Tx:         fmt.Sprintf("0x%x", encodedTx),
+		Hash:       fmt.Sprintf("0x%x", builtTx.Hash().Bytes()),
+		Nonce:      builtTx.Nonce,
+		Gas:        builtTx.Gas(),
+		Commission: builtTx.CommissionInBaseCoin().String(),
+	}, nil
+}
+
+// nextNonce returns the nonce of the next transaction of the sender of the description
+func nextNonce(description *transaction.TxDescription, height int) (uint64, error) {
+	address, err := description.Sender()
+	if err != nil {
+		return 0, rpctypes.RPCError{Code: 400, Message: "Nonce or valid sender address is required", Data: err.Error()}
+	}
+
+	cState, release, err := GetStateForHeight(height)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	return cState.Accounts.GetNonce(address) + 1, nil
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/cache"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/server"
+	"reflect"
+	"strings"
+)
+
+// heightChecks are run before cached responses of the routes are served,
+// since their data may be pruned before the state of the height
+var heightChecks = map[string]func(height uint64) error{
+	"events": func(height uint64) error { return blockchain.CheckEventsHeight(height) },
+}
+
+// cachedRPCFunc wraps f so that its responses to requests with explicit height are kept in the
+// response cache of the blockchain. Requests for the current or future heights are not cached, because
+// the current block may be not committed yet.
+func cachedRPCFunc(route string, f interface{}, args string) *rpcserver.RPCFunc {
+	heightIndex := -1
+	for i, name := range strings.Split(args, ",") {
+		if name == "height" {
+			heightIndex = i
+		}
+	}
+
+	if heightIndex == -1 {
+		panic(fmt.Sprintf("route %s has no height argument", route))
+	}
+
+	fn := reflect.ValueOf(f)
+	wrapper := reflect.MakeFunc(fn.Type(), func(in []reflect.Value) []reflect.Value {
+		height := heightOf(in[heightIndex])
+		if height == 0 || height >= blockchain.Height() {
+			return fn.Call(in)
+		}
+
+		// f returns the error itself
+		if check, ok := heightChecks[route]; ok && check(height) != nil {
+			return fn.Call(in)
+		}
+
+		params := make([]interface{}, len(in))
+		for i, arg := range in {
+			params[i] = arg.Interface()
+		}
+		key := cache.Key(route, params...)
+
+		if out, ok := blockchain.ResponseCache().Get(key); ok {
+			return out.([]reflect.Value)
+		}
+
+		out := fn.Call(in)
+		if out[len(out)-1].IsNil() {
+			blockchain.ResponseCache().Set(key, height, out)
+		}
+
+		return out
+	})
+
+	return rpcserver.NewRPCFunc(wrapper.Interface(), args)
+}
+
+func heightOf(value reflect.Value) uint64 {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.Int() > 0 {
+			return uint64(value.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint()
+	}
+
+	return 0
+}
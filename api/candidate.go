@@ -14,14 +14,28 @@ type Stake struct {
 	BipValue string `json:"bip_value"`
 }
 
+type CommissionUpdate struct {
+	Commission uint   `json:"commission"`
+	Height     uint64 `json:"height"`
+}
+
+type DelegationPolicy struct {
+	MinStake     string   `json:"min_stake"`
+	AllowedCoins []string `json:"allowed_coins,omitempty"`
+	Private      bool     `json:"private"`
+	Whitelist    []string `json:"whitelist,omitempty"`
+}
+
 type CandidateResponse struct {
-	RewardAddress string  `json:"reward_address"`
-	OwnerAddress  string  `json:"owner_address"`
-	TotalStake    string  `json:"total_stake"`
-	PubKey        string  `json:"pub_key"`
-	Commission    uint    `json:"commission"`
-	Stakes        []Stake `json:"stakes,omitempty"`
-	Status        byte    `json:"status"`
+	RewardAddress    string            `json:"reward_address"`
+	OwnerAddress     string            `json:"owner_address"`
+	TotalStake       string            `json:"total_stake"`
+	PubKey           string            `json:"pub_key"`
+	Commission       uint              `json:"commission"`
+	CommissionUpdate *CommissionUpdate `json:"commission_update,omitempty"`
+	DelegationPolicy *DelegationPolicy `json:"delegation_policy,omitempty"`
+	Stakes           []Stake           `json:"stakes,omitempty"`
+	Status           byte              `json:"status"`
 }
 
 func makeResponseCandidate(state *state.State, c candidates.Candidate, includeStakes bool) CandidateResponse {
@@ -34,6 +48,26 @@ func makeResponseCandidate(state *state.State, c candidates.Candidate, includeSt
 		Status:        c.Status,
 	}
 
+	if update := c.GetCommissionUpdate(); update != nil {
+		candidate.CommissionUpdate = &CommissionUpdate{
+			Commission: update.Commission,
+			Height:     update.Height,
+		}
+	}
+
+	if policy := c.GetDelegationPolicy(); policy != nil {
+		candidate.DelegationPolicy = &DelegationPolicy{
+			MinStake: policy.MinStake.String(),
+			Private:  policy.Private,
+		}
+		for _, coin := range policy.AllowedCoins {
+			candidate.DelegationPolicy.AllowedCoins = append(candidate.DelegationPolicy.AllowedCoins, coin.String())
+		}
+		for _, address := range policy.Whitelist {
+			candidate.DelegationPolicy.Whitelist = append(candidate.DelegationPolicy.Whitelist, address.String())
+		}
+	}
+
 	if includeStakes {
 		stakes := state.Candidates.GetStakes(c.PubKey)
 		candidate.Stakes = make([]Stake, len(stakes))
@@ -51,10 +85,11 @@ func makeResponseCandidate(state *state.State, c candidates.Candidate, includeSt
 }
 
 func Candidate(pubkey types.Pubkey, height int) (*CandidateResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	if height != 0 {
 		cState.Lock()
@@ -1,10 +1,11 @@
 package api
 
 func Candidates(height int, includeStakes bool) (*[]CandidateResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	if height != 0 {
 		cState.Lock()
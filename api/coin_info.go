@@ -15,10 +15,11 @@ type CoinInfoResponse struct {
 }
 
 func CoinInfo(coinSymbol string, height int) (*CoinInfoResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
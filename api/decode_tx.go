@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+type DecodeTxResponse struct {
+	Hash          string            `json:"hash"`
+	From          string            `json:"from"`
+	Nonce         uint64            `json:"nonce"`
+	ChainID       uint8             `json:"chain_id"`
+	Gas           int64             `json:"gas"`
+	GasPrice      uint32            `json:"gas_price"`
+	GasCoin       string            `json:"gas_coin"`
+	Type          uint8             `json:"type"`
+	TypeName      string            `json:"type_name"`
+	Data          json.RawMessage   `json:"data"`
+	Payload       []byte            `json:"payload"`
+	ServiceData   []byte            `json:"service_data"`
+	SignatureType uint8             `json:"signature_type"`
+	Multisig      *DecodeTxMultisig `json:"multisig,omitempty"`
+}
+
+type DecodeTxMultisig struct {
+	Threshold uint             `json:"threshold"`
+	Votes     uint             `json:"votes"`
+	Signers   []DecodeTxSigner `json:"signers"`
+}
+
+type DecodeTxSigner struct {
+	Address string `json:"address"`
+	Weight  uint   `json:"weight"`
+}
+
+// DecodeTx decodes raw transaction without sending it to the network. Weights of signers of
+// multi-signature transactions are taken from the state at the given height.
+func DecodeTx(tx []byte, height int) (*DecodeTxResponse, error) {
+	decodedTx, err := transaction.TxDecoder.DecodeFromBytes(tx)
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: "Cannot decode transaction", Data: err.Error()}
+	}
+
+	sender, err := decodedTx.Sender()
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: "Cannot recover sender", Data: err.Error()}
+	}
+
+	data, err := encodeTxData(decodedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DecodeTxResponse{
+		Hash:          fmt.Sprintf("Mt%x", tmTypes.Tx(tx).Hash()),
+		From:          sender.String(),
+		Nonce:         decodedTx.Nonce,
+		ChainID:       uint8(decodedTx.ChainID),
+		Gas:           decodedTx.Gas(),
+		GasPrice:      decodedTx.GasPrice,
+		GasCoin:       decodedTx.GasCoin.String(),
+		Type:          uint8(decodedTx.Type),
+		TypeName:      decodedTx.Type.Name(),
+		Data:          data,
+		Payload:       decodedTx.Payload,
+		ServiceData:   decodedTx.ServiceData,
+		SignatureType: uint8(decodedTx.SignatureType),
+	}
+
+	if decodedTx.SignatureType != transaction.SigTypeMulti {
+		return response, nil
+	}
+
+	signers, err := decodedTx.Signers()
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: "Cannot recover signers", Data: err.Error()}
+	}
+
+	cState, release, err := GetStateForHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	// multisig data is empty if the account does not exist, so weights of all signers are zero
+	multisig := cState.Accounts.GetAccount(sender).Multisig()
+
+	response.Multisig = &DecodeTxMultisig{
+		Threshold: multisig.Threshold,
+		Signers:   make([]DecodeTxSigner, 0, len(signers)),
+	}
+	for _, signer := range signers {
+		weight := multisig.GetWeight(signer)
+
+		response.Multisig.Votes += weight
+		response.Multisig.Signers = append(response.Multisig.Signers, DecodeTxSigner{
+			Address: signer.String(),
+			Weight:  weight,
+		})
+	}
+
+	return response, nil
+}
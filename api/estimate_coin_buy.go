@@ -16,10 +16,11 @@ type EstimateCoinBuyResponse struct {
 }
 
 func EstimateCoinBuy(coinToSellString string, coinToBuyString string, valueToBuy *big.Int, height int) (*EstimateCoinBuyResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
@@ -18,10 +18,11 @@ type EstimateCoinSellResponse struct {
 func EstimateCoinSell(
 	coinToSellString string, coinToBuyString string, valueToSell *big.Int, height int) (*EstimateCoinSellResponse,
 	error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
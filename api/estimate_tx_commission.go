@@ -13,10 +13,11 @@ type TxCommissionResponse struct {
 }
 
 func EstimateTxCommission(tx []byte, height int) (*TxCommissionResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
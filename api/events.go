@@ -9,6 +9,10 @@ type EventsResponse struct {
 }
 
 func Events(height uint64) (*EventsResponse, error) {
+	if err := blockchain.CheckEventsHeight(height); err != nil {
+		return nil, err
+	}
+
 	return &EventsResponse{
 		Events: blockchain.GetEventsDB().LoadEvents(uint32(height)),
 	}, nil
@@ -0,0 +1,188 @@
+package graphql
+
+import (
+	"fmt"
+	"github.com/graphql-go/graphql/language/ast"
+	"strconv"
+)
+
+const (
+	// defaultListLimit is a number of items returned by list fields without limit argument
+	defaultListLimit = 100
+
+	// maxListLimit is the maximum value of limit argument of list fields
+	maxListLimit = 1000
+)
+
+// listSizes are upper bounds of sizes of list fields without limit argument
+var listSizes = map[string]int{
+	"tags": 10,
+}
+
+// pagedFields are list fields accepting limit argument
+var pagedFields = map[string]bool{
+	"balances":     true,
+	"candidates":   true,
+	"stakes":       true,
+	"frozenFunds":  true,
+	"transactions": true,
+	"events":       true,
+}
+
+// queryCost estimates the number of values resolved by the operation. Each field costs 1,
+// selections of list fields are multiplied by the maximum number of items in the list.
+// Document should be validated before, so fragments have no cycles.
+func queryCost(doc *ast.Document, operationName string, variables map[string]interface{}) (int, error) {
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, definition := range doc.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	c := &costCalculator{fragments: fragments, variables: variables}
+
+	cost := 0
+	for _, definition := range doc.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		if operationName != "" && (operation.Name == nil || operation.Name.Value != operationName) {
+			continue
+		}
+
+		c.defaults = map[string]ast.Value{}
+		for _, definition := range operation.VariableDefinitions {
+			if definition.DefaultValue != nil {
+				c.defaults[definition.Variable.Name.Value] = definition.DefaultValue
+			}
+		}
+
+		operationCost, err := c.selectionSet(operation.SelectionSet)
+		if err != nil {
+			return 0, err
+		}
+		cost += operationCost
+	}
+
+	return cost, nil
+}
+
+type costCalculator struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+
+	// defaults are default values of variables of the current operation
+	defaults map[string]ast.Value
+}
+
+func (c *costCalculator) selectionSet(set *ast.SelectionSet) (int, error) {
+	if set == nil {
+		return 0, nil
+	}
+
+	cost := 0
+	for _, selection := range set.Selections {
+		var selectionCost int
+		var err error
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selectionCost, err = c.field(selection)
+		case *ast.InlineFragment:
+			selectionCost, err = c.selectionSet(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			fragment, ok := c.fragments[selection.Name.Value]
+			if !ok {
+				return 0, fmt.Errorf("unknown fragment %s", selection.Name.Value)
+			}
+			selectionCost, err = c.selectionSet(fragment.SelectionSet)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+		cost += selectionCost
+	}
+
+	return cost, nil
+}
+
+func (c *costCalculator) field(field *ast.Field) (int, error) {
+	childrenCost, err := c.selectionSet(field.SelectionSet)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := c.listSize(field)
+	if err != nil {
+		return 0, err
+	}
+
+	// negative limits and limits above maxListLimit are rejected by resolvers
+	if size < 0 {
+		size = 0
+	}
+	if size > maxListLimit {
+		size = maxListLimit
+	}
+
+	return 1 + size*childrenCost, nil
+}
+
+// listSize returns the maximum number of items returned by the field, 1 for fields which are not lists
+func (c *costCalculator) listSize(field *ast.Field) (int, error) {
+	name := field.Name.Value
+
+	if size, ok := listSizes[name]; ok {
+		return size, nil
+	}
+
+	if !pagedFields[name] {
+		return 1, nil
+	}
+
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "limit" {
+			continue
+		}
+
+		return c.valueInt(argument.Value)
+	}
+
+	return defaultListLimit, nil
+}
+
+// valueInt returns integer value of the argument, variables without values take their defaults
+func (c *costCalculator) valueInt(value ast.Value) (int, error) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		return strconv.Atoi(value.Value)
+	case *ast.Variable:
+		name := value.Name.Value
+		if variable := c.variables[name]; variable != nil {
+			return variableInt(variable)
+		}
+
+		if defaultValue, ok := c.defaults[name]; ok {
+			return c.valueInt(defaultValue)
+		}
+
+		return defaultListLimit, nil
+	}
+
+	return 0, fmt.Errorf("invalid limit %v", value.GetValue())
+}
+
+func variableInt(value interface{}) (int, error) {
+	switch value := value.(type) {
+	case int:
+		return value, nil
+	case float64:
+		return int(value), nil
+	}
+
+	return 0, fmt.Errorf("invalid limit %v", value)
+}
@@ -0,0 +1,192 @@
+// Package graphql implements optional GraphQL API over state, blocks and events.
+// Queries are limited by their estimated cost, see queryCost.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/api/limiter"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/rs/cors"
+	"github.com/tendermint/tendermint/libs/log"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// route is a name of the endpoint for API limiter
+	route = "graphql"
+
+	maxRequestSize = 1 << 20
+)
+
+type requestBody struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves GraphQL queries sent by POST requests with JSON body or by GET requests with query parameters
+type Handler struct {
+	schema     graphql.Schema
+	maxCost    int
+	apiLimiter *limiter.Limiter
+}
+
+func NewHandler(blockchain *minter.Blockchain, client *rpc.Local, maxCost int, apiLimiter *limiter.Limiter) (*Handler, error) {
+	schema, err := newSchema(&resolver{blockchain: blockchain, client: client})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{schema: schema, maxCost: maxCost, apiLimiter: apiLimiter}, nil
+}
+
+// Run serves GraphQL API on graphql_listen_addr
+func Run(blockchain *minter.Blockchain, client *rpc.Local, cfg *config.Config, apiLimiter *limiter.Limiter, logger log.Logger) error {
+	addr, err := url.Parse(cfg.GraphQLListenAddress)
+	if err != nil {
+		return err
+	}
+
+	handler, err := NewHandler(blockchain, client, cfg.GraphQLMaxCost, apiLimiter)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", handler)
+
+	c := cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"POST", "GET"},
+		AllowedHeaders: []string{"Content-Type", "X-API-Key"},
+	})
+
+	logger.Info("Starting GraphQL API", "addr", cfg.GraphQLListenAddress)
+	return http.ListenAndServe(addr.Host, c.Handler(mux))
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.apiLimiter.AllowHTTP(r, route); err != nil {
+		writeErrors(w, limiter.HTTPStatus(err), err)
+		return
+	}
+
+	body, err := parseRequest(w, r)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err)
+		return
+	}
+
+	status, result := h.execute(r.Context(), body)
+	writeResult(w, status, result)
+}
+
+func parseRequest(w http.ResponseWriter, r *http.Request) (*requestBody, error) {
+	body := new(requestBody)
+
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		body.Query = query.Get("query")
+		body.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &body.Variables); err != nil {
+				return nil, fmt.Errorf("invalid variables: %s", err)
+			}
+		}
+	case http.MethodPost:
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		decoder.UseNumber()
+		if err := decoder.Decode(body); err != nil {
+			return nil, fmt.Errorf("invalid request body: %s", err)
+		}
+	default:
+		return nil, errors.New("only GET and POST requests are supported")
+	}
+
+	if body.Query == "" {
+		return nil, errors.New("query is empty")
+	}
+
+	return body, nil
+}
+
+// execute parses, validates and runs the query if its cost is below the limit
+func (h *Handler) execute(ctx context.Context, body *requestBody) (int, *graphql.Result) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(body.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql.ValidateDocument(&h.schema, doc, nil)
+	if !validation.IsValid {
+		return http.StatusBadRequest, &graphql.Result{Errors: validation.Errors}
+	}
+
+	variables := normalizeVariables(body.Variables)
+
+	cost, err := queryCost(doc, body.OperationName, variables)
+	if err != nil {
+		return http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if h.maxCost > 0 && cost > h.maxCost {
+		err := fmt.Errorf("query cost %d exceeds the limit %d", cost, h.maxCost)
+		return http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	req := newRequest()
+	defer req.release()
+
+	return http.StatusOK, graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: body.OperationName,
+		Args:          variables,
+		Context:       context.WithValue(ctx, requestKey{}, req),
+	})
+}
+
+// normalizeVariables converts numbers decoded from JSON to int or float64 expected by the schema
+func normalizeVariables(variables map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(variables))
+	for name, value := range variables {
+		number, ok := value.(json.Number)
+		if !ok {
+			result[name] = value
+			continue
+		}
+
+		if i, err := number.Int64(); err == nil {
+			result[name] = int(i)
+		} else if f, err := number.Float64(); err == nil {
+			result[name] = f
+		} else {
+			result[name] = number.String()
+		}
+	}
+
+	return result
+}
+
+func writeErrors(w http.ResponseWriter, status int, err error) {
+	writeResult(w, status, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+}
+
+func writeResult(w http.ResponseWriter, status int, result *graphql.Result) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(result)
+}
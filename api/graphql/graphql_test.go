@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"encoding/json"
+	"github.com/MinterTeam/minter-go-node/api/limiter"
+	"github.com/graphql-go/graphql/language/parser"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestQueryCost(t *testing.T) {
+	cases := []struct {
+		query     string
+		variables map[string]interface{}
+		cost      int
+	}{
+		{`{ coin(symbol: "BIP") { symbol volume } }`, nil, 3},
+		{`{ candidates(limit: 10) { publicKey stakes(limit: 5) { owner value } } }`, nil, 1 + 10*(1+1+5*2)},
+		{`{ candidates { publicKey } }`, nil, 1 + defaultListLimit},
+		{`query($limit: Int) { candidates(limit: $limit) { publicKey } }`, map[string]interface{}{"limit": 3}, 4},
+		{`query($limit: Int = 500) { candidates(limit: $limit) { publicKey } }`, nil, 501},
+		{`query($limit: Int = 500) { candidates(limit: $limit) { publicKey } }`, map[string]interface{}{"limit": 3}, 4},
+		{`query($limit: Int) { candidates(limit: $limit) { publicKey } }`, nil, 1 + defaultListLimit},
+		{`{ candidates(limit: 100000) { publicKey } }`, nil, 1 + maxListLimit},
+		{`{ block(height: 1) { transactions(limit: 2) { hash tags { key } } } }`, nil, 1 + 1 + 2*(1+1+10)},
+		{`{ account(address: "Mx00") { ...balances } } fragment balances on Account { balances(limit: 2) { coin value } }`, nil, 1 + 1 + 2*2},
+	}
+
+	for _, c := range cases {
+		doc, err := parser.Parse(parser.ParseParams{Source: c.query})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cost, err := queryCost(doc, "", c.variables)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if cost != c.cost {
+			t.Errorf("Wrong cost of %s. Expected %d, got %d", c.query, c.cost, cost)
+		}
+	}
+}
+
+func TestHandlerRejectsQueries(t *testing.T) {
+	handler, err := NewHandler(nil, nil, 100, limiter.NewLimiter(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"cost":       `{ candidates { publicKey totalStake } }`,
+		"validation": `{ unknown }`,
+		"syntax":     `{ candidates `,
+	}
+
+	for name, query := range cases {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/graphql?query="+url.QueryEscape(query), nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", name, w.Code)
+		}
+
+		var result struct {
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil || len(result.Errors) == 0 {
+			t.Errorf("%s: expected errors in response", name)
+		}
+
+		if name == "cost" && !strings.Contains(result.Errors[0].Message, "exceeds the limit") {
+			t.Errorf("Unexpected error: %s", result.Errors[0].Message)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query": ""}`)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for empty query, got %d", w.Code)
+	}
+}
+
+func TestPage(t *testing.T) {
+	if start, end, err := page(map[string]interface{}{"offset": 2, "limit": 5}, 4); err != nil || start != 2 || end != 4 {
+		t.Errorf("Wrong page bounds %d, %d, %v", start, end, err)
+	}
+
+	if _, _, err := page(map[string]interface{}{"limit": maxListLimit + 1}, 4); err == nil {
+		t.Errorf("Expected error for limit above %d", maxListLimit)
+	}
+
+	if _, _, err := page(map[string]interface{}{"offset": -1}, 4); err == nil {
+		t.Errorf("Expected error for negative offset")
+	}
+}
@@ -0,0 +1,954 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	compact_db "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/graphql-go/graphql"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	core_types "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"sort"
+	"sync"
+	"time"
+)
+
+type requestKey struct{}
+
+// request keeps states used by resolvers of a single query, they are released after the query is executed
+type request struct {
+	lock     sync.Mutex
+	states   map[int]*state.State
+	releases []func()
+}
+
+func newRequest() *request {
+	return &request{states: map[int]*state.State{}}
+}
+
+func (r *request) release() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, release := range r.releases {
+		release()
+	}
+	r.releases = nil
+	r.states = map[int]*state.State{}
+}
+
+type resolver struct {
+	blockchain *minter.Blockchain
+	client     *rpc.Local
+}
+
+// state returns state at height or the current one if height is 0, candidates of historical states are loaded
+func (r *resolver) state(ctx context.Context, height int) (*state.State, error) {
+	if height < 0 {
+		return nil, errors.New("height should not be negative")
+	}
+
+	req := ctx.Value(requestKey{}).(*request)
+	req.lock.Lock()
+	defer req.lock.Unlock()
+
+	if cState, ok := req.states[height]; ok {
+		return cState, nil
+	}
+
+	if height == 0 {
+		req.states[height] = r.blockchain.CurrentState()
+		return req.states[height], nil
+	}
+
+	cState, release, err := r.blockchain.GetStateForHeight(uint64(height))
+	if err != nil {
+		return nil, err
+	}
+
+	cState.Lock()
+	cState.Candidates.LoadCandidates()
+	cState.Unlock()
+
+	req.states[height] = cState
+	req.releases = append(req.releases, release)
+
+	return cState, nil
+}
+
+type accountSource struct {
+	state   *state.State
+	address types.Address
+}
+
+type candidateSource struct {
+	state     *state.State
+	height    int
+	candidate *candidates.Candidate
+}
+
+type blockSource struct {
+	block *core_types.ResultBlock
+}
+
+type balance struct {
+	Coin  string `json:"coin"`
+	Value string `json:"value"`
+}
+
+type coin struct {
+	Name           string `json:"name"`
+	Symbol         string `json:"symbol"`
+	Volume         string `json:"volume"`
+	Crr            uint   `json:"crr"`
+	ReserveBalance string `json:"reserveBalance"`
+	MaxSupply      string `json:"maxSupply"`
+}
+
+type stake struct {
+	Owner    string `json:"owner"`
+	Coin     string `json:"coin"`
+	Value    string `json:"value"`
+	BipValue string `json:"bipValue"`
+}
+
+type frozenFund struct {
+	Height             uint64  `json:"height"`
+	Address            string  `json:"address"`
+	CandidatePublicKey *string `json:"candidatePublicKey"`
+	Coin               string  `json:"coin"`
+	Value              string  `json:"value"`
+}
+
+type tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type tx struct {
+	Hash        string `json:"hash"`
+	RawTx       string `json:"rawTx"`
+	Height      int64  `json:"height"`
+	Index       uint32 `json:"index"`
+	From        string `json:"from"`
+	Nonce       uint64 `json:"nonce"`
+	GasPrice    uint32 `json:"gasPrice"`
+	GasCoin     string `json:"gasCoin"`
+	Gas         int64  `json:"gas"`
+	Type        uint8  `json:"type"`
+	Data        string `json:"data"`
+	Payload     string `json:"payload"`
+	ServiceData string `json:"serviceData"`
+	Tags        []tag  `json:"tags"`
+	Code        uint32 `json:"code"`
+	Log         string `json:"log"`
+}
+
+type event struct {
+	Type               string  `json:"type"`
+	Address            string  `json:"address"`
+	Amount             string  `json:"amount"`
+	Coin               string  `json:"coin"`
+	Role               *string `json:"role"`
+	ValidatorPublicKey string  `json:"validatorPublicKey"`
+}
+
+var (
+	heightArg = &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: 0,
+		Description:  "Height of the state, the latest one if omitted",
+	}
+	offsetArg = &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: 0,
+	}
+	limitArg = &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: defaultListLimit,
+		Description:  fmt.Sprintf("Maximum number of items, %d if omitted, up to %d", defaultListLimit, maxListLimit),
+	}
+)
+
+func nonNull(t graphql.Output) graphql.Output {
+	return graphql.NewNonNull(t)
+}
+
+func listOf(t graphql.Output) graphql.Output {
+	return graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(t)))
+}
+
+// newSchema creates schema of the API. Big numbers are represented by strings.
+func newSchema(r *resolver) (graphql.Schema, error) {
+	balanceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Balance",
+		Fields: graphql.Fields{
+			"coin":  &graphql.Field{Type: nonNull(graphql.String)},
+			"value": &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	accountType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.Fields{
+			"address": &graphql.Field{
+				Type: nonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*accountSource).address.String(), nil
+				},
+			},
+			"nonce": &graphql.Field{
+				Type:    nonNull(graphql.Int),
+				Resolve: r.accountNonce,
+			},
+			"balances": &graphql.Field{
+				Type: listOf(balanceType),
+				Args: graphql.FieldConfigArgument{
+					"coin":   {Type: graphql.String},
+					"offset": offsetArg,
+					"limit":  limitArg,
+				},
+				Resolve: r.accountBalances,
+			},
+		},
+	})
+
+	coinType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Coin",
+		Fields: graphql.Fields{
+			"name":           &graphql.Field{Type: nonNull(graphql.String)},
+			"symbol":         &graphql.Field{Type: nonNull(graphql.String)},
+			"volume":         &graphql.Field{Type: nonNull(graphql.String)},
+			"crr":            &graphql.Field{Type: nonNull(graphql.Int)},
+			"reserveBalance": &graphql.Field{Type: nonNull(graphql.String)},
+			"maxSupply":      &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	stakeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Stake",
+		Fields: graphql.Fields{
+			"owner":    &graphql.Field{Type: nonNull(graphql.String)},
+			"coin":     &graphql.Field{Type: nonNull(graphql.String)},
+			"value":    &graphql.Field{Type: nonNull(graphql.String)},
+			"bipValue": &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	candidateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Candidate",
+		Fields: graphql.Fields{
+			"publicKey": &graphql.Field{
+				Type: nonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*candidateSource).candidate.PubKey.String(), nil
+				},
+			},
+			"rewardAddress": &graphql.Field{
+				Type: nonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*candidateSource).candidate.RewardAddress.String(), nil
+				},
+			},
+			"ownerAddress": &graphql.Field{
+				Type: nonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*candidateSource).candidate.OwnerAddress.String(), nil
+				},
+			},
+			"commission": &graphql.Field{
+				Type: nonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*candidateSource).candidate.Commission, nil
+				},
+			},
+			"status": &graphql.Field{
+				Type:        nonNull(graphql.Int),
+				Description: "1 - offline, 2 - online",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*candidateSource).candidate.Status, nil
+				},
+			},
+			"totalStake": &graphql.Field{
+				Type:    nonNull(graphql.String),
+				Resolve: r.candidateTotalStake,
+			},
+			"stakes": &graphql.Field{
+				Type: listOf(stakeType),
+				Args: graphql.FieldConfigArgument{
+					"owner":  {Type: graphql.String},
+					"coin":   {Type: graphql.String},
+					"offset": offsetArg,
+					"limit":  limitArg,
+				},
+				Resolve: r.candidateStakes,
+			},
+		},
+	})
+
+	frozenFundType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "FrozenFund",
+		Fields: graphql.Fields{
+			"height":             &graphql.Field{Type: nonNull(graphql.Int), Description: "Height of unfreezing"},
+			"address":            &graphql.Field{Type: nonNull(graphql.String)},
+			"candidatePublicKey": &graphql.Field{Type: graphql.String},
+			"coin":               &graphql.Field{Type: nonNull(graphql.String)},
+			"value":              &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	tagType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Tag",
+		Fields: graphql.Fields{
+			"key":   &graphql.Field{Type: nonNull(graphql.String)},
+			"value": &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	txType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.Fields{
+			"hash":        &graphql.Field{Type: nonNull(graphql.String)},
+			"rawTx":       &graphql.Field{Type: nonNull(graphql.String)},
+			"height":      &graphql.Field{Type: nonNull(graphql.Int)},
+			"index":       &graphql.Field{Type: nonNull(graphql.Int)},
+			"from":        &graphql.Field{Type: nonNull(graphql.String)},
+			"nonce":       &graphql.Field{Type: nonNull(graphql.Int)},
+			"gasPrice":    &graphql.Field{Type: nonNull(graphql.Int)},
+			"gasCoin":     &graphql.Field{Type: nonNull(graphql.String)},
+			"gas":         &graphql.Field{Type: nonNull(graphql.Int)},
+			"type":        &graphql.Field{Type: nonNull(graphql.Int)},
+			"data":        &graphql.Field{Type: nonNull(graphql.String), Description: "JSON encoded data of the transaction"},
+			"payload":     &graphql.Field{Type: nonNull(graphql.String), Description: "Base64 encoded payload"},
+			"serviceData": &graphql.Field{Type: nonNull(graphql.String), Description: "Base64 encoded service data"},
+			"tags":        &graphql.Field{Type: listOf(tagType)},
+			"code":        &graphql.Field{Type: nonNull(graphql.Int)},
+			"log":         &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	eventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Event",
+		Fields: graphql.Fields{
+			"type":               &graphql.Field{Type: nonNull(graphql.String), Description: "reward, slash or unbond"},
+			"address":            &graphql.Field{Type: nonNull(graphql.String)},
+			"amount":             &graphql.Field{Type: nonNull(graphql.String)},
+			"coin":               &graphql.Field{Type: nonNull(graphql.String)},
+			"role":               &graphql.Field{Type: graphql.String, Description: "Role of the address in reward events"},
+			"validatorPublicKey": &graphql.Field{Type: nonNull(graphql.String)},
+		},
+	})
+
+	eventsArgs := graphql.FieldConfigArgument{
+		"type":      {Type: graphql.String, Description: "reward, slash or unbond"},
+		"address":   {Type: graphql.String},
+		"publicKey": {Type: graphql.String},
+		"offset":    offsetArg,
+		"limit":     limitArg,
+	}
+
+	blockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"height": &graphql.Field{
+				Type: nonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*blockSource).block.Block.Height, nil
+				},
+			},
+			"hash": &graphql.Field{
+				Type: nonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return hex.EncodeToString(p.Source.(*blockSource).block.Block.Hash()), nil
+				},
+			},
+			"time": &graphql.Field{
+				Type: nonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*blockSource).block.Block.Time.Format(time.RFC3339Nano), nil
+				},
+			},
+			"numTxs": &graphql.Field{
+				Type: nonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return len(p.Source.(*blockSource).block.Block.Data.Txs), nil
+				},
+			},
+			"size": &graphql.Field{
+				Type: nonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*blockSource).block.Block.Size(), nil
+				},
+			},
+			"proposerAddress": &graphql.Field{
+				Type:        nonNull(graphql.String),
+				Description: "Tendermint address of the proposer",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*blockSource).block.Block.ProposerAddress.String(), nil
+				},
+			},
+			"transactions": &graphql.Field{
+				Type: listOf(txType),
+				Args: graphql.FieldConfigArgument{
+					"offset": offsetArg,
+					"limit":  limitArg,
+				},
+				Resolve: r.blockTransactions,
+			},
+			"events": &graphql.Field{
+				Type:    listOf(eventType),
+				Args:    eventsArgs,
+				Resolve: r.blockEvents,
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"account": &graphql.Field{
+				Type: nonNull(accountType),
+				Args: graphql.FieldConfigArgument{
+					"address": {Type: graphql.NewNonNull(graphql.String)},
+					"height":  heightArg,
+				},
+				Resolve: r.account,
+			},
+			"coin": &graphql.Field{
+				Type: coinType,
+				Args: graphql.FieldConfigArgument{
+					"symbol": {Type: graphql.NewNonNull(graphql.String)},
+					"height": heightArg,
+				},
+				Resolve: r.coin,
+			},
+			"candidate": &graphql.Field{
+				Type: candidateType,
+				Args: graphql.FieldConfigArgument{
+					"publicKey": {Type: graphql.NewNonNull(graphql.String)},
+					"height":    heightArg,
+				},
+				Resolve: r.candidate,
+			},
+			"candidates": &graphql.Field{
+				Type: listOf(candidateType),
+				Args: graphql.FieldConfigArgument{
+					"status": {Type: graphql.Int, Description: "1 - offline, 2 - online, all candidates if omitted"},
+					"height": heightArg,
+					"offset": offsetArg,
+					"limit":  limitArg,
+				},
+				Resolve: r.candidates,
+			},
+			"frozenFunds": &graphql.Field{
+				Type: listOf(frozenFundType),
+				Args: graphql.FieldConfigArgument{
+					"address": {Type: graphql.String},
+					"coin":    {Type: graphql.String},
+					"height":  heightArg,
+					"offset":  offsetArg,
+					"limit":   limitArg,
+				},
+				Resolve: r.frozenFunds,
+			},
+			"block": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"height": {Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.block,
+			},
+			"transaction": &graphql.Field{
+				Type: txType,
+				Args: graphql.FieldConfigArgument{
+					"hash": {Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.transaction,
+			},
+			"events": &graphql.Field{
+				Type: listOf(eventType),
+				Args: graphql.FieldConfigArgument{
+					"height":    {Type: graphql.NewNonNull(graphql.Int)},
+					"type":      eventsArgs["type"],
+					"address":   eventsArgs["address"],
+					"publicKey": eventsArgs["publicKey"],
+					"offset":    offsetArg,
+					"limit":     limitArg,
+				},
+				Resolve: r.events,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func (r *resolver) account(p graphql.ResolveParams) (interface{}, error) {
+	address, err := decodePrefixed(p.Args["address"].(string), "Mx", types.AddressLength)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %s", err)
+	}
+
+	cState, err := r.state(p.Context, p.Args["height"].(int))
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountSource{state: cState, address: types.BytesToAddress(address)}, nil
+}
+
+func (r *resolver) accountNonce(p graphql.ResolveParams) (interface{}, error) {
+	source := p.Source.(*accountSource)
+
+	source.state.RLock()
+	defer source.state.RUnlock()
+
+	return source.state.Accounts.GetNonce(source.address), nil
+}
+
+func (r *resolver) accountBalances(p graphql.ResolveParams) (interface{}, error) {
+	source := p.Source.(*accountSource)
+
+	source.state.RLock()
+	balances := source.state.Accounts.GetBalances(source.address)
+	source.state.RUnlock()
+
+	result := make([]balance, 0, len(balances))
+	for symbol, value := range balances {
+		if c, ok := p.Args["coin"].(string); ok && symbol != types.StrToCoinSymbol(c) {
+			continue
+		}
+		result = append(result, balance{Coin: symbol.String(), Value: value.String()})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Coin < result[j].Coin
+	})
+
+	start, end, err := page(p.Args, len(result))
+	if err != nil {
+		return nil, err
+	}
+
+	return result[start:end], nil
+}
+
+func (r *resolver) coin(p graphql.ResolveParams) (interface{}, error) {
+	cState, err := r.state(p.Context, p.Args["height"].(int))
+	if err != nil {
+		return nil, err
+	}
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	model := cState.Coins.GetCoin(types.StrToCoinSymbol(p.Args["symbol"].(string)))
+	if model == nil {
+		return nil, nil
+	}
+
+	return &coin{
+		Name:           model.Name(),
+		Symbol:         model.Symbol().String(),
+		Volume:         model.Volume().String(),
+		Crr:            model.Crr(),
+		ReserveBalance: model.Reserve().String(),
+		MaxSupply:      model.MaxSupply().String(),
+	}, nil
+}
+
+func (r *resolver) candidate(p graphql.ResolveParams) (interface{}, error) {
+	pubkey, err := decodePrefixed(p.Args["publicKey"].(string), "Mp", types.PubKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %s", err)
+	}
+
+	height := p.Args["height"].(int)
+	cState, err := r.state(p.Context, height)
+	if err != nil {
+		return nil, err
+	}
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	candidate := cState.Candidates.GetCandidate(types.BytesToPubkey(pubkey))
+	if candidate == nil {
+		return nil, nil
+	}
+
+	return &candidateSource{state: cState, height: height, candidate: candidate}, nil
+}
+
+func (r *resolver) candidates(p graphql.ResolveParams) (interface{}, error) {
+	height := p.Args["height"].(int)
+	cState, err := r.state(p.Context, height)
+	if err != nil {
+		return nil, err
+	}
+
+	cState.RLock()
+	list := cState.Candidates.GetCandidates()
+	cState.RUnlock()
+
+	result := make([]*candidateSource, 0, len(list))
+	for _, candidate := range list {
+		if status, ok := p.Args["status"].(int); ok && int(candidate.Status) != status {
+			continue
+		}
+		result = append(result, &candidateSource{state: cState, height: height, candidate: candidate})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].candidate.PubKey.String() < result[j].candidate.PubKey.String()
+	})
+
+	start, end, err := page(p.Args, len(result))
+	if err != nil {
+		return nil, err
+	}
+
+	return result[start:end], nil
+}
+
+func (r *resolver) candidateTotalStake(p graphql.ResolveParams) (interface{}, error) {
+	source := p.Source.(*candidateSource)
+
+	source.state.RLock()
+	defer source.state.RUnlock()
+
+	return source.state.Candidates.GetTotalStake(source.candidate.PubKey).String(), nil
+}
+
+func (r *resolver) candidateStakes(p graphql.ResolveParams) (interface{}, error) {
+	source := p.Source.(*candidateSource)
+
+	if source.height != 0 {
+		source.state.Lock()
+		source.state.Candidates.LoadStakesOfCandidate(source.candidate.PubKey)
+		source.state.Unlock()
+	}
+
+	var owner *types.Address
+	if value, ok := p.Args["owner"].(string); ok {
+		decoded, err := decodePrefixed(value, "Mx", types.AddressLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid owner: %s", err)
+		}
+		address := types.BytesToAddress(decoded)
+		owner = &address
+	}
+
+	source.state.RLock()
+	stakes := source.state.Candidates.GetStakes(source.candidate.PubKey)
+	source.state.RUnlock()
+
+	result := make([]stake, 0, len(stakes))
+	for _, s := range stakes {
+		if owner != nil && s.Owner != *owner {
+			continue
+		}
+		if c, ok := p.Args["coin"].(string); ok && s.Coin != types.StrToCoinSymbol(c) {
+			continue
+		}
+		result = append(result, stake{
+			Owner:    s.Owner.String(),
+			Coin:     s.Coin.String(),
+			Value:    s.Value.String(),
+			BipValue: s.BipValue.String(),
+		})
+	}
+
+	start, end, err := page(p.Args, len(result))
+	if err != nil {
+		return nil, err
+	}
+
+	return result[start:end], nil
+}
+
+func (r *resolver) frozenFunds(p graphql.ResolveParams) (interface{}, error) {
+	height := p.Args["height"].(int)
+	cState, err := r.state(p.Context, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		height = int(r.blockchain.Height())
+	}
+
+	var address *types.Address
+	if value, ok := p.Args["address"].(string); ok {
+		decoded, err := decodePrefixed(value, "Mx", types.AddressLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %s", err)
+		}
+		a := types.BytesToAddress(decoded)
+		address = &a
+	}
+
+	appState := new(types.AppState)
+	cState.RLock()
+	cState.FrozenFunds.Export(appState, uint64(height))
+	cState.RUnlock()
+
+	result := make([]frozenFund, 0, len(appState.FrozenFunds))
+	for _, fund := range appState.FrozenFunds {
+		if address != nil && fund.Address != *address {
+			continue
+		}
+		if c, ok := p.Args["coin"].(string); ok && fund.Coin != types.StrToCoinSymbol(c) {
+			continue
+		}
+
+		item := frozenFund{
+			Height:  fund.Height,
+			Address: fund.Address.String(),
+			Coin:    fund.Coin.String(),
+			Value:   fund.Value,
+		}
+		if fund.CandidateKey != nil {
+			pubkey := fund.CandidateKey.String()
+			item.CandidatePublicKey = &pubkey
+		}
+		result = append(result, item)
+	}
+
+	start, end, err := page(p.Args, len(result))
+	if err != nil {
+		return nil, err
+	}
+
+	return result[start:end], nil
+}
+
+func (r *resolver) block(p graphql.ResolveParams) (interface{}, error) {
+	height := int64(p.Args["height"].(int))
+
+	block, err := r.client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockSource{block: block}, nil
+}
+
+func (r *resolver) blockTransactions(p graphql.ResolveParams) (interface{}, error) {
+	block := p.Source.(*blockSource).block.Block
+
+	start, end, err := page(p.Args, len(block.Data.Txs))
+	if err != nil {
+		return nil, err
+	}
+
+	if start == end {
+		return []*tx{}, nil
+	}
+
+	blockResults, err := r.client.BlockResults(&block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blockResults.TxsResults) != len(block.Data.Txs) {
+		return nil, errors.New("block results not found")
+	}
+
+	result := make([]*tx, 0, end-start)
+	for i := start; i < end; i++ {
+		decoded, err := newTx(block.Data.Txs[i], block.Height, uint32(i), blockResults.TxsResults[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, decoded)
+	}
+
+	return result, nil
+}
+
+func (r *resolver) blockEvents(p graphql.ResolveParams) (interface{}, error) {
+	return r.loadEvents(p.Args, p.Source.(*blockSource).block.Block.Height)
+}
+
+func (r *resolver) transaction(p graphql.ResolveParams) (interface{}, error) {
+	value := p.Args["hash"].(string)
+	if len(value) < 2 || value[:2] != "Mt" {
+		return nil, errors.New("invalid hash: should start with Mt")
+	}
+
+	hash, err := hex.DecodeString(value[2:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+
+	result, err := r.client.Tx(hash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTx(result.Tx, result.Height, result.Index, &result.TxResult)
+}
+
+func (r *resolver) events(p graphql.ResolveParams) (interface{}, error) {
+	return r.loadEvents(p.Args, int64(p.Args["height"].(int)))
+}
+
+func (r *resolver) loadEvents(args map[string]interface{}, height int64) (interface{}, error) {
+	if height <= 0 {
+		return nil, errors.New("height should be positive")
+	}
+
+	if err := r.blockchain.CheckEventsHeight(uint64(height)); err != nil {
+		return nil, err
+	}
+
+	var address *types.Address
+	if value, ok := args["address"].(string); ok {
+		decoded, err := decodePrefixed(value, "Mx", types.AddressLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %s", err)
+		}
+		a := types.BytesToAddress(decoded)
+		address = &a
+	}
+
+	var pubkey *types.Pubkey
+	if value, ok := args["publicKey"].(string); ok {
+		decoded, err := decodePrefixed(value, "Mp", types.PubKeyLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %s", err)
+		}
+		pk := types.BytesToPubkey(decoded)
+		pubkey = &pk
+	}
+
+	eventType, filterType := args["type"].(string)
+
+	result := []event{}
+	for _, e := range r.blockchain.GetEventsDB().LoadEvents(uint32(height)) {
+		item := newEvent(e)
+		if item == nil {
+			continue
+		}
+		if filterType && item.Type != eventType {
+			continue
+		}
+		if address != nil && item.Address != address.String() {
+			continue
+		}
+		if pubkey != nil && item.ValidatorPublicKey != pubkey.String() {
+			continue
+		}
+		result = append(result, *item)
+	}
+
+	start, end, err := page(args, len(result))
+	if err != nil {
+		return nil, err
+	}
+
+	return result[start:end], nil
+}
+
+func newTx(raw tmTypes.Tx, height int64, index uint32, result *abci.ResponseDeliverTx) (*tx, error) {
+	decodedTx, err := transaction.TxDecoder.DecodeFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := decodedTx.Sender()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(decodedTx.GetDecodedData())
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []tag{}
+	if len(result.Events) != 0 {
+		for _, attribute := range result.Events[0].Attributes {
+			tags = append(tags, tag{Key: string(attribute.Key), Value: string(attribute.Value)})
+		}
+	}
+
+	return &tx{
+		Hash:        fmt.Sprintf("Mt%x", raw.Hash()),
+		RawTx:       fmt.Sprintf("%x", []byte(raw)),
+		Height:      height,
+		Index:       index,
+		From:        sender.String(),
+		Nonce:       decodedTx.Nonce,
+		GasPrice:    decodedTx.GasPrice,
+		GasCoin:     decodedTx.GasCoin.String(),
+		Gas:         decodedTx.Gas(),
+		Type:        uint8(decodedTx.Type),
+		Data:        string(data),
+		Payload:     base64.StdEncoding.EncodeToString(decodedTx.Payload),
+		ServiceData: base64.StdEncoding.EncodeToString(decodedTx.ServiceData),
+		Tags:        tags,
+		Code:        result.Code,
+		Log:         result.Log,
+	}, nil
+}
+
+func newEvent(e compact_db.Event) *event {
+	switch e := e.(type) {
+	case *compact_db.RewardEvent:
+		role := e.Role
+		// rewards are paid in base coin
+		return &event{Type: "reward", Address: e.Address.String(), Amount: e.Amount, Coin: types.GetBaseCoin().String(), Role: &role, ValidatorPublicKey: e.ValidatorPubKey.String()}
+	case *compact_db.SlashEvent:
+		return &event{Type: "slash", Address: e.Address.String(), Amount: e.Amount, Coin: e.Coin.String(), ValidatorPublicKey: e.ValidatorPubKey.String()}
+	case *compact_db.UnbondEvent:
+		return &event{Type: "unbond", Address: e.Address.String(), Amount: e.Amount, Coin: e.Coin.String(), ValidatorPublicKey: e.ValidatorPubKey.String()}
+	}
+
+	return nil
+}
+
+// page returns bounds of the page of a list of length n selected by offset and limit arguments
+func page(args map[string]interface{}, n int) (int, int, error) {
+	offset, _ := args["offset"].(int)
+	limit, ok := args["limit"].(int)
+	if !ok {
+		limit = defaultListLimit
+	}
+	if offset < 0 || limit < 0 {
+		return 0, 0, errors.New("offset and limit should not be negative")
+	}
+	if limit > maxListLimit {
+		return 0, 0, fmt.Errorf("limit should not exceed %d", maxListLimit)
+	}
+
+	if offset > n {
+		offset = n
+	}
+
+	end := offset + limit
+	if end > n {
+		end = n
+	}
+
+	return offset, end, nil
+}
+
+func decodePrefixed(value string, prefix string, length int) ([]byte, error) {
+	if len(value) != len(prefix)+length*2 || value[:len(prefix)] != prefix {
+		return nil, fmt.Errorf("should be %d bytes with %s prefix", length, prefix)
+	}
+
+	return hex.DecodeString(value[len(prefix):])
+}
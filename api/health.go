@@ -0,0 +1,21 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/MinterTeam/minter-go-node/core/health"
+	"net/http"
+)
+
+// healthHandler serves the report as JSON with status 503 if any check failed
+func healthHandler(check func() *health.Report) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := check()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
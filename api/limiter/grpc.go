@@ -0,0 +1,109 @@
+package limiter
+
+import (
+	"context"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/textproto"
+	"strings"
+	"unicode"
+)
+
+const forwardedForHeader = "X-Forwarded-For"
+
+// UnaryServerInterceptor rejects unary API v2 calls not allowed by the limiter
+func (l *Limiter) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.allowGRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects API v2 streams not allowed by the limiter. Cost is taken once per stream.
+func (l *Limiter) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.allowGRPC(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// HeaderMatcher passes API key and real IP headers from the gateway to gRPC metadata
+func (l *Limiter) HeaderMatcher(key string) (string, bool) {
+	key = textproto.CanonicalMIMEHeaderKey(key)
+	if key == textproto.CanonicalMIMEHeaderKey(keyHeader) ||
+		(l.realIPHeader != "" && key == textproto.CanonicalMIMEHeaderKey(l.realIPHeader)) {
+		return strings.ToLower(key), true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func (l *Limiter) allowGRPC(ctx context.Context, fullMethod string) error {
+	if !l.enabled {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	header := func(name string) string {
+		return strings.Join(md.Get(name), ",")
+	}
+
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = stripPort(p.Addr.String())
+	}
+
+	// calls from loopback are proxied by the gateway, which appends address of its client to X-Forwarded-For
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.IsLoopback() {
+		if forwarded := lastAddress(header(forwardedForHeader)); forwarded != "" {
+			ip = forwarded
+		}
+		ip = l.clientIP(ip, header)
+	}
+
+	if err := l.Allow(header(keyHeader), ip, methodRoute(fullMethod)); err != nil {
+		return Status(err)
+	}
+
+	return nil
+}
+
+// Status converts limiter error to gRPC status error
+func Status(err error) error {
+	switch err {
+	case ErrInvalidKey:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case ErrRouteDenied:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case ErrLimitReached:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// methodRoute converts gRPC method name to the name of API v1 route, e.g.
+// /api_pb.ApiService/EstimateCoinSell to estimate_coin_sell. Acronyms are kept in one word: APR to apr.
+func methodRoute(fullMethod string) string {
+	name := []rune(fullMethod[strings.LastIndex(fullMethod, "/")+1:])
+
+	var route strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(name[i-1]) || (i+1 < len(name) && unicode.IsLower(name[i+1]))) {
+				route.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		route.WriteRune(r)
+	}
+
+	return route.String()
+}
@@ -0,0 +1,100 @@
+package limiter
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/server"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/types"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	keyHeader     = "X-API-Key"
+	keyQueryParam = "api_key"
+
+	// maxRequestSize is a max size of JSON-RPC request read to find its method, it is the same as the limit of API v1 server
+	maxRequestSize = 1000000
+)
+
+// Middleware returns API v1 middleware which rejects requests not allowed by the limiter
+func (l *Limiter) Middleware() func(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+		if !l.enabled {
+			return f
+		}
+
+		return func(w http.ResponseWriter, r *http.Request) {
+			route, err := rpcRoute(r)
+			if err == nil {
+				err = l.AllowHTTP(r, route)
+			}
+			if err != nil {
+				code := HTTPStatus(err)
+				rpcserver.WriteRPCResponseHTTPError(w, code, rpctypes.NewRPCErrorResponse(rpctypes.JSONRPCStringID(""), code, err.Error(), ""))
+				return
+			}
+
+			f(w, r)
+		}
+	}
+}
+
+// AllowHTTP checks HTTP request to the route. API key is taken from X-API-Key header or api_key query parameter.
+func (l *Limiter) AllowHTTP(r *http.Request, route string) error {
+	if !l.enabled {
+		return nil
+	}
+
+	key := r.Header.Get(keyHeader)
+	if key == "" {
+		key = r.URL.Query().Get(keyQueryParam)
+	}
+
+	return l.Allow(key, l.clientIP(r.RemoteAddr, r.Header.Get), route)
+}
+
+// rpcRoute returns name of API v1 route. JSON-RPC requests are sent to the root path, their route is the method.
+// Bodies larger than maxRequestSize are not read and ErrRequestTooLarge is returned.
+func rpcRoute(r *http.Request) (string, error) {
+	route := strings.TrimPrefix(r.URL.Path, "/")
+	if route != "" || r.Method != http.MethodPost || r.Body == nil {
+		return route, nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRequestSize+1))
+	if err != nil {
+		return route, nil
+	}
+	if len(body) > maxRequestSize {
+		return route, ErrRequestTooLarge
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	var request struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return route, nil
+	}
+
+	return request.Method, nil
+}
+
+// HTTPStatus returns HTTP status code of the limiter error
+func HTTPStatus(err error) int {
+	switch err {
+	case ErrInvalidKey:
+		return http.StatusUnauthorized
+	case ErrRouteDenied:
+		return http.StatusForbidden
+	case ErrLimitReached:
+		return http.StatusTooManyRequests
+	case ErrRequestTooLarge:
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return http.StatusInternalServerError
+}
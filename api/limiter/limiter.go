@@ -0,0 +1,221 @@
+// Package limiter implements API keys, per-key and per-IP rate limits and route access lists
+// shared by API v1 and API v2.
+package limiter
+
+import (
+	"errors"
+	"github.com/MinterTeam/minter-go-node/config"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// idleBucketTTL is a time after which unused per-IP buckets are dropped
+	idleBucketTTL = 10 * time.Minute
+
+	defaultCost = 1
+)
+
+var (
+	ErrInvalidKey   = errors.New("invalid API key")
+	ErrRouteDenied  = errors.New("route is not allowed")
+	ErrLimitReached = errors.New("rate limit reached")
+
+	ErrRequestTooLarge = errors.New("request body is too large")
+)
+
+// Limiter decides whether a request of a client to a route should be served
+type Limiter struct {
+	enabled    bool
+	requireKey bool
+
+	ipRate  float64
+	ipBurst int
+
+	realIPHeader string
+
+	allowRoutes map[string]bool
+	denyRoutes  map[string]bool
+	costs       map[string]int
+
+	keys map[string]config.APIKeyConfig
+
+	lock      sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+
+	now func() time.Time
+}
+
+// NewLimiter creates limiter from config. Nil config creates limiter which allows every request.
+func NewLimiter(cfg *config.APIGatewayConfig) *Limiter {
+	l := &Limiter{
+		allowRoutes: map[string]bool{},
+		denyRoutes:  map[string]bool{},
+		costs:       map[string]int{},
+		keys:        map[string]config.APIKeyConfig{},
+		buckets:     map[string]*bucket{},
+		now:         time.Now,
+	}
+
+	if cfg == nil {
+		return l
+	}
+
+	l.enabled = cfg.Enabled
+	l.requireKey = cfg.RequireKey
+	l.ipRate = cfg.IPRateLimit
+	l.ipBurst = cfg.IPRateBurst
+	l.realIPHeader = cfg.RealIPHeader
+
+	for _, route := range cfg.AllowRoutes {
+		l.allowRoutes[route] = true
+	}
+
+	for _, route := range cfg.DenyRoutes {
+		l.denyRoutes[route] = true
+	}
+
+	for route, cost := range cfg.RouteCosts {
+		l.costs[route] = cost
+	}
+
+	for _, key := range cfg.Keys {
+		l.keys[key.Key] = key
+	}
+
+	return l
+}
+
+// Allow checks access of the client identified by API key (may be empty) and IP to the route and
+// consumes route's cost from the client's bucket
+func (l *Limiter) Allow(key, ip, route string) error {
+	if !l.enabled {
+		return nil
+	}
+
+	if l.denyRoutes[route] || (len(l.allowRoutes) != 0 && !l.allowRoutes[route]) {
+		return ErrRouteDenied
+	}
+
+	rate, burst, id := l.ipRate, l.ipBurst, "ip:"+ip
+	if key != "" {
+		keyCfg, ok := l.keys[key]
+		if !ok {
+			return ErrInvalidKey
+		}
+
+		rate, burst, id = keyCfg.RateLimit, keyCfg.RateBurst, "key:"+key
+	} else if l.requireKey {
+		return ErrInvalidKey
+	}
+
+	if rate <= 0 {
+		return nil
+	}
+
+	cost, ok := l.costs[route]
+	if !ok {
+		cost = defaultCost
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[id]
+	if !ok {
+		b = newBucket(rate, burst, now)
+		l.buckets[id] = b
+	}
+
+	if !b.take(float64(cost), now) {
+		return ErrLimitReached
+	}
+
+	return nil
+}
+
+// prune drops buckets which were not used for idleBucketTTL, such buckets are full anyway
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < idleBucketTTL {
+		return
+	}
+
+	for id, b := range l.buckets {
+		if now.Sub(b.last) > idleBucketTTL {
+			delete(l.buckets, id)
+		}
+	}
+
+	l.lastPrune = now
+}
+
+// clientIP returns address of the client, preferring the value of real IP header set by a trusted proxy
+func (l *Limiter) clientIP(remoteAddr string, header func(string) string) string {
+	if l.realIPHeader != "" {
+		if ip := lastAddress(header(l.realIPHeader)); ip != "" {
+			return ip
+		}
+	}
+
+	return stripPort(remoteAddr)
+}
+
+// lastAddress returns the last address of comma separated list, which is the one added by the nearest proxy
+func lastAddress(value string) string {
+	parts := strings.Split(value, ",")
+	return strings.TrimSpace(parts[len(parts)-1])
+}
+
+func stripPort(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
+
+// bucket is a token bucket refilled with rate tokens per second up to burst tokens
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64, burst int, now time.Time) *bucket {
+	capacity := math.Max(float64(burst), 1)
+
+	return &bucket{
+		rate:   rate,
+		burst:  capacity,
+		tokens: capacity,
+		last:   now,
+	}
+}
+
+func (b *bucket) take(n float64, now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+
+	// a request which costs more than the whole bucket is allowed when the bucket is full
+	if n > b.burst {
+		n = b.burst
+	}
+
+	if b.tokens < n {
+		return false
+	}
+
+	b.tokens -= n
+	return true
+}
@@ -0,0 +1,182 @@
+package limiter
+
+import (
+	"github.com/MinterTeam/minter-go-node/config"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(cfg *config.APIGatewayConfig) (*Limiter, *time.Time) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(cfg)
+	l.now = func() time.Time {
+		return now
+	}
+
+	return l, &now
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	l, _ := newTestLimiter(&config.APIGatewayConfig{
+		Enabled:     false,
+		IPRateLimit: 1,
+		IPRateBurst: 1,
+		DenyRoutes:  []string{"status"},
+	})
+
+	for i := 0; i < 10; i++ {
+		if err := l.Allow("", "127.0.0.1", "status"); err != nil {
+			t.Fatalf("Disabled limiter should allow every request, got %s", err)
+		}
+	}
+}
+
+func TestLimiterIPRate(t *testing.T) {
+	l, now := newTestLimiter(&config.APIGatewayConfig{
+		Enabled:     true,
+		IPRateLimit: 1,
+		IPRateBurst: 2,
+		RouteCosts:  map[string]int{"candidates": 2},
+	})
+
+	if err := l.Allow("", "1.1.1.1", "status"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.Allow("", "1.1.1.1", "candidates"); err != ErrLimitReached {
+		t.Fatalf("Expected %s, got %v", ErrLimitReached, err)
+	}
+
+	if err := l.Allow("", "2.2.2.2", "candidates"); err != nil {
+		t.Fatalf("Limits of different IPs should not affect each other, got %s", err)
+	}
+
+	*now = now.Add(time.Second)
+	if err := l.Allow("", "1.1.1.1", "candidates"); err != nil {
+		t.Fatalf("Bucket should be refilled, got %s", err)
+	}
+}
+
+func TestLimiterKeys(t *testing.T) {
+	l, _ := newTestLimiter(&config.APIGatewayConfig{
+		Enabled:     true,
+		RequireKey:  true,
+		IPRateLimit: 1,
+		IPRateBurst: 1,
+		Keys: []config.APIKeyConfig{
+			{Key: "limited", RateLimit: 1, RateBurst: 3},
+			{Key: "unlimited"},
+		},
+	})
+
+	if err := l.Allow("", "1.1.1.1", "status"); err != ErrInvalidKey {
+		t.Fatalf("Expected %s, got %v", ErrInvalidKey, err)
+	}
+
+	if err := l.Allow("unknown", "1.1.1.1", "status"); err != ErrInvalidKey {
+		t.Fatalf("Expected %s, got %v", ErrInvalidKey, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := l.Allow("limited", "1.1.1.1", "status"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := l.Allow("limited", "2.2.2.2", "status"); err != ErrLimitReached {
+		t.Fatalf("Key limit should not depend on IP, expected %s, got %v", ErrLimitReached, err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if err := l.Allow("unlimited", "1.1.1.1", "status"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLimiterRoutes(t *testing.T) {
+	l, _ := newTestLimiter(&config.APIGatewayConfig{
+		Enabled:     true,
+		AllowRoutes: []string{"status", "candidates"},
+		DenyRoutes:  []string{"candidates"},
+	})
+
+	if err := l.Allow("", "1.1.1.1", "status"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.Allow("", "1.1.1.1", "candidates"); err != ErrRouteDenied {
+		t.Fatalf("Expected %s, got %v", ErrRouteDenied, err)
+	}
+
+	if err := l.Allow("", "1.1.1.1", "address"); err != ErrRouteDenied {
+		t.Fatalf("Expected %s, got %v", ErrRouteDenied, err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	l, _ := newTestLimiter(&config.APIGatewayConfig{
+		Enabled:      true,
+		DenyRoutes:   []string{"candidates"},
+		IPRateLimit:  1,
+		IPRateBurst:  1,
+		RealIPHeader: "X-Real-IP",
+		Keys:         []config.APIKeyConfig{{Key: "key"}},
+	})
+
+	handler := l.Middleware()(func(w http.ResponseWriter, r *http.Request) {})
+
+	serve := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		handler(w, r)
+		return w.Code
+	}
+
+	r := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":"1","method":"candidates"}`))
+	if code := serve(r); code != http.StatusForbidden {
+		t.Fatalf("JSON-RPC method should be denied, got %d", code)
+	}
+
+	r = httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":"1","method":"status","params":"`+strings.Repeat("a", maxRequestSize)+`"}`))
+	if code := serve(r); code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("Expected %d, got %d", http.StatusRequestEntityTooLarge, code)
+	}
+
+	r = httptest.NewRequest("GET", "/status", nil)
+	r.Header.Set("X-Real-IP", "1.1.1.1")
+	if code := serve(r); code != http.StatusOK {
+		t.Fatalf("Expected %d, got %d", http.StatusOK, code)
+	}
+
+	r = httptest.NewRequest("GET", "/status", nil)
+	r.Header.Set("X-Real-IP", "1.1.1.1")
+	if code := serve(r); code != http.StatusTooManyRequests {
+		t.Fatalf("Expected %d, got %d", http.StatusTooManyRequests, code)
+	}
+
+	r = httptest.NewRequest("GET", "/status?api_key=key", nil)
+	r.Header.Set("X-Real-IP", "1.1.1.1")
+	if code := serve(r); code != http.StatusOK {
+		t.Fatalf("Expected %d, got %d", http.StatusOK, code)
+	}
+}
+
+func TestMethodRoute(t *testing.T) {
+	cases := map[string]string{
+		"/api_pb.ApiService/EstimateCoinSell":         "estimate_coin_sell",
+		"/api_pb.ApiService/Candidates":               "candidates",
+		"/api_pb.ApiService/MinGasPrice":              "min_gas_price",
+		"/updates_pb.UpdatesService/SubscribeUpdates": "subscribe_updates",
+		"/rewards_pb.RewardsService/APR":              "apr",
+		"/rewards_pb.RewardsService/Rewards":          "rewards",
+	}
+
+	for method, route := range cases {
+		if got := methodRoute(method); got != route {
+			t.Errorf("Route of %s should be %s, got %s", method, route, got)
+		}
+	}
+}
@@ -11,10 +11,11 @@ type MissedBlocksResponse struct {
 }
 
 func MissedBlocks(pubkey types.Pubkey, height int) (*MissedBlocksResponse, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	if height != 0 {
 		cState.Lock()
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-go-node/core/events"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/types"
+)
+
+// QueryEvents returns reward, slash and unbond events matching the filter over a range of heights
+func QueryEvents(ctx context.Context, fromHeight, toHeight uint64, eventTypes, addresses, validators, coins, roles, groupBy []string, page, perPage int) (*events.Response, error) {
+	filter, err := events.ParseFilter(eventTypes, addresses, validators, coins, roles)
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: err.Error()}
+	}
+
+	fields, err := events.ParseGroupBy(groupBy)
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: err.Error()}
+	}
+
+	q := events.Query{Filter: filter, GroupBy: fields}
+	if err := q.SetRange(fromHeight, toHeight, blockchain.Height()); err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: err.Error()}
+	}
+	q.SetPage(page, perPage)
+
+	if err := blockchain.CheckEventsHeight(q.FromHeight); err != nil {
+		return nil, err
+	}
+
+	result, err := events.Find(ctx, blockchain.GetEventsDB(), q)
+	if err != nil {
+		return nil, err
+	}
+
+	return events.NewResponse(q, result), nil
+}
@@ -75,6 +75,12 @@ func encodeTxData(decodedTx *transaction.Transaction) ([]byte, error) {
 		return cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.CreateMultisigData))
 	case transaction.TypeEditCandidate:
 		return cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.EditCandidateData))
+	case transaction.TypeEditCandidateCommission:
+		return cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.EditCandidateCommissionData))
+	case transaction.TypeEditCandidatePublicKey:
+		return cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.EditCandidatePublicKeyData))
+	case transaction.TypeSetDelegationPolicy:
+		return cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.SetDelegationPolicyData))
 	}
 
 	return nil, rpctypes.RPCError{Code: 500, Message: "unknown tx type"}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/payloads"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/types"
+)
+
+// maxPayloadSearchPerPage limits the number of transactions returned by a single search
+const maxPayloadSearchPerPage = 100
+
+// TransactionsByPayload returns successful transactions whose payload or service data equals the value
+// or starts with it if prefix is true
+func TransactionsByPayload(value string, field string, prefix bool, page, perPage int) (*[]TransactionResponse, error) {
+	f, err := payloads.ParseField(field)
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: err.Error()}
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 || perPage > maxPayloadSearchPerPage {
+		perPage = maxPayloadSearchPerPage
+	}
+
+	entries, err := blockchain.PayloadIndex().Find(f, []byte(value), prefix, (page-1)*perPage, perPage)
+	if err == payloads.ErrDisabled {
+		return nil, rpctypes.RPCError{Code: 404, Message: err.Error()}
+	}
+	if err != nil {
+		return nil, rpctypes.RPCError{Code: 400, Message: err.Error()}
+	}
+
+	result := make([]TransactionResponse, 0, len(entries))
+	for _, entry := range entries {
+		tx, err := Transaction(entry.Hash)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *tx)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: candidate.proto
+
+package candidate_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CommissionUpdateRequest struct {
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// height of the state, 0 means the latest one
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommissionUpdateRequest) Reset()         { *m = CommissionUpdateRequest{} }
+func (m *CommissionUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*CommissionUpdateRequest) ProtoMessage()    {}
+func (*CommissionUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515fb327dd7e8b3d, []int{0}
+}
+
+func (m *CommissionUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommissionUpdateRequest.Unmarshal(m, b)
+}
+func (m *CommissionUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommissionUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *CommissionUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionUpdateRequest.Merge(m, src)
+}
+func (m *CommissionUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_CommissionUpdateRequest.Size(m)
+}
+func (m *CommissionUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionUpdateRequest proto.InternalMessageInfo
+
+func (m *CommissionUpdateRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *CommissionUpdateRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type CommissionUpdateResponse struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Commission uint32 `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	// scheduled change of the commission, empty if there is none
+	CommissionUpdate     *PendingCommission `protobuf:"bytes,3,opt,name=commission_update,json=commissionUpdate,proto3" json:"commission_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CommissionUpdateResponse) Reset()         { *m = CommissionUpdateResponse{} }
+func (m *CommissionUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*CommissionUpdateResponse) ProtoMessage()    {}
+func (*CommissionUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515fb327dd7e8b3d, []int{1}
+}
+
+func (m *CommissionUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommissionUpdateResponse.Unmarshal(m, b)
+}
+func (m *CommissionUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommissionUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *CommissionUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionUpdateResponse.Merge(m, src)
+}
+func (m *CommissionUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_CommissionUpdateResponse.Size(m)
+}
+func (m *CommissionUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionUpdateResponse proto.InternalMessageInfo
+
+func (m *CommissionUpdateResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *CommissionUpdateResponse) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *CommissionUpdateResponse) GetCommissionUpdate() *PendingCommission {
+	if m != nil {
+		return m.CommissionUpdate
+	}
+	return nil
+}
+
+type PendingCommission struct {
+	Commission           uint32   `protobuf:"varint,1,opt,name=commission,proto3" json:"commission,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingCommission) Reset()         { *m = PendingCommission{} }
+func (m *PendingCommission) String() string { return proto.CompactTextString(m) }
+func (*PendingCommission) ProtoMessage()    {}
+func (*PendingCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515fb327dd7e8b3d, []int{2}
+}
+
+func (m *PendingCommission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingCommission.Unmarshal(m, b)
+}
+func (m *PendingCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingCommission.Marshal(b, m, deterministic)
+}
+func (m *PendingCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommission.Merge(m, src)
+}
+func (m *PendingCommission) XXX_Size() int {
+	return xxx_messageInfo_PendingCommission.Size(m)
+}
+func (m *PendingCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommission proto.InternalMessageInfo
+
+func (m *PendingCommission) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *PendingCommission) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type DelegationPolicyRequest struct {
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// height of the state, 0 means the latest one
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegationPolicyRequest) Reset()         { *m = DelegationPolicyRequest{} }
+func (m *DelegationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegationPolicyRequest) ProtoMessage()    {}
+func (*DelegationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515fb327dd7e8b3d, []int{3}
+}
+
+func (m *DelegationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationPolicyRequest.Unmarshal(m, b)
+}
+func (m *DelegationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *DelegationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPolicyRequest.Merge(m, src)
+}
+func (m *DelegationPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_DelegationPolicyRequest.Size(m)
+}
+func (m *DelegationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationPolicyRequest proto.InternalMessageInfo
+
+func (m *DelegationPolicyRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *DelegationPolicyRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type DelegationPolicyResponse struct {
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	MinStake  string `protobuf:"bytes,2,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
+	// coins allowed for delegation, empty means any coin
+	AllowedCoins []string `protobuf:"bytes,3,rep,name=allowed_coins,json=allowedCoins,proto3" json:"allowed_coins,omitempty"`
+	Private      bool     `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	// addresses allowed to delegate to the private candidate
+	Whitelist            []string `protobuf:"bytes,5,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegationPolicyResponse) Reset()         { *m = DelegationPolicyResponse{} }
+func (m *DelegationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegationPolicyResponse) ProtoMessage()    {}
+func (*DelegationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515fb327dd7e8b3d, []int{4}
+}
+
+func (m *DelegationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationPolicyResponse.Unmarshal(m, b)
+}
+func (m *DelegationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *DelegationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPolicyResponse.Merge(m, src)
+}
+func (m *DelegationPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_DelegationPolicyResponse.Size(m)
+}
+func (m *DelegationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationPolicyResponse proto.InternalMessageInfo
+
+func (m *DelegationPolicyResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *DelegationPolicyResponse) GetMinStake() string {
+	if m != nil {
+		return m.MinStake
+	}
+	return ""
+}
+
+func (m *DelegationPolicyResponse) GetAllowedCoins() []string {
+	if m != nil {
+		return m.AllowedCoins
+	}
+	return nil
+}
+
+func (m *DelegationPolicyResponse) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *DelegationPolicyResponse) GetWhitelist() []string {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CommissionUpdateRequest)(nil), "candidate_pb.CommissionUpdateRequest")
+	proto.RegisterType((*CommissionUpdateResponse)(nil), "candidate_pb.CommissionUpdateResponse")
+	proto.RegisterType((*PendingCommission)(nil), "candidate_pb.PendingCommission")
+	proto.RegisterType((*DelegationPolicyRequest)(nil), "candidate_pb.DelegationPolicyRequest")
+	proto.RegisterType((*DelegationPolicyResponse)(nil), "candidate_pb.DelegationPolicyResponse")
+}
+
+func init() {
+	proto.RegisterFile("candidate.proto", fileDescriptor_515fb327dd7e8b3d)
+}
+
+var fileDescriptor_515fb327dd7e8b3d = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xd5, 0x24, 0xa5, 0xd4, 0x9f, 0x56, 0xa4, 0xb3, 0xa0, 0xa3, 0x50, 0xc0, 0x32, 0x50, 0x19,
+	0x90, 0x62, 0x51, 0x8e, 0x10, 0x76, 0x65, 0x11, 0x4d, 0xc5, 0xda, 0x72, 0xec, 0x2f, 0xe7, 0xab,
+	0xce, 0xcc, 0x90, 0x99, 0xb4, 0x8a, 0x10, 0x1b, 0xf6, 0xac, 0xe0, 0x0e, 0x1c, 0x80, 0x15, 0xe7,
+	0xe0, 0x0a, 0x1c, 0x04, 0x65, 0x92, 0x26, 0xc1, 0x91, 0x15, 0x24, 0x96, 0xff, 0xe9, 0xbd, 0xa7,
+	0x37, 0xef, 0xff, 0x81, 0xfb, 0x79, 0xa6, 0x0a, 0x2a, 0x32, 0x87, 0x3d, 0x33, 0xd1, 0x4e, 0xf3,
+	0xc3, 0x15, 0x90, 0x9a, 0x61, 0xf7, 0xb4, 0xd4, 0xba, 0xac, 0x30, 0xc9, 0x0c, 0x25, 0x99, 0x52,
+	0xda, 0x65, 0x8e, 0xb4, 0xb2, 0x0b, 0x6e, 0x34, 0x80, 0x93, 0xbe, 0x1e, 0x8f, 0xc9, 0x5a, 0xd2,
+	0xea, 0xbd, 0x99, 0x8b, 0x24, 0x7e, 0x98, 0xa2, 0x75, 0xfc, 0x11, 0x80, 0x99, 0x0e, 0x2b, 0xca,
+	0xd3, 0x2b, 0x9c, 0x09, 0x16, 0xb2, 0x38, 0x90, 0xc1, 0x02, 0xb9, 0xc0, 0x19, 0x7f, 0x00, 0xfb,
+	0x23, 0xa4, 0x72, 0xe4, 0x44, 0x2b, 0x64, 0xf1, 0x9e, 0x5c, 0x4e, 0xd1, 0x77, 0x06, 0x62, 0xdb,
+	0xd2, 0x1a, 0xad, 0x2c, 0xee, 0xf2, 0x7c, 0x0c, 0x90, 0xaf, 0xa4, 0xde, 0xf7, 0x48, 0x6e, 0x20,
+	0xfc, 0x1d, 0x1c, 0xaf, 0xa7, 0x74, 0xea, 0xbd, 0x45, 0x3b, 0x64, 0xf1, 0xbd, 0xf3, 0x27, 0xbd,
+	0xcd, 0x57, 0xf7, 0x06, 0xa8, 0x0a, 0x52, 0xe5, 0x3a, 0x88, 0xec, 0xe4, 0xb5, 0x50, 0xd1, 0x05,
+	0x1c, 0x6f, 0xd1, 0x6a, 0x11, 0xd8, 0x56, 0x84, 0xa6, 0x67, 0x0f, 0xe0, 0xe4, 0x2d, 0x56, 0x58,
+	0xfa, 0x76, 0x07, 0xba, 0xa2, 0x7c, 0xf6, 0x9f, 0x45, 0xfe, 0x60, 0x20, 0xb6, 0x2d, 0xff, 0xad,
+	0xc8, 0x87, 0x10, 0x8c, 0x49, 0xa5, 0xd6, 0x65, 0x57, 0xe8, 0x6d, 0x03, 0x79, 0x30, 0x26, 0x75,
+	0x39, 0x9f, 0xf9, 0x53, 0x38, 0xca, 0xaa, 0x4a, 0xdf, 0x60, 0x91, 0xe6, 0x9a, 0x94, 0x15, 0xed,
+	0xb0, 0x1d, 0x07, 0xf2, 0x70, 0x09, 0xf6, 0xe7, 0x18, 0x17, 0x70, 0xd7, 0x4c, 0xe8, 0x7a, 0x5e,
+	0xf0, 0x5e, 0xc8, 0xe2, 0x03, 0x79, 0x3b, 0xf2, 0x53, 0x08, 0x6e, 0x46, 0xe4, 0xb0, 0x22, 0xeb,
+	0xc4, 0x1d, 0x2f, 0x5d, 0x03, 0xe7, 0x3f, 0x5b, 0xd0, 0xe9, 0xdf, 0x6e, 0xe2, 0x12, 0x27, 0xd7,
+	0x94, 0x23, 0xff, 0xc2, 0xa0, 0x53, 0xbf, 0x09, 0xfe, 0xfc, 0xef, 0x8d, 0x35, 0x9c, 0x61, 0xf7,
+	0x6c, 0x17, 0x6d, 0xd1, 0x48, 0xf4, 0xf2, 0xf3, 0xaf, 0xdf, 0x5f, 0x5b, 0xcf, 0x78, 0x94, 0xac,
+	0xf8, 0xc9, 0xc7, 0x75, 0x47, 0x9f, 0x92, 0x8d, 0x25, 0x7e, 0x63, 0xd0, 0xa9, 0x57, 0x5b, 0xcf,
+	0xd3, 0xb0, 0xcd, 0xee, 0xd9, 0x2e, 0xda, 0x32, 0xcf, 0x6b, 0x9f, 0xe7, 0x15, 0x7f, 0xd1, 0x94,
+	0xa7, 0x58, 0x29, 0x53, 0xe3, 0xa5, 0xc3, 0x7d, 0xff, 0x27, 0xdf, 0xfc, 0x19, 0x00, 0x96, 0xea,
+	0x6f, 0x02, 0xd2, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CandidateServiceClient is the client API for CandidateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CandidateServiceClient interface {
+	CommissionUpdate(ctx context.Context, in *CommissionUpdateRequest, opts ...grpc.CallOption) (*CommissionUpdateResponse, error)
+	DelegationPolicy(ctx context.Context, in *DelegationPolicyRequest, opts ...grpc.CallOption) (*DelegationPolicyResponse, error)
+}
+
+type candidateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCandidateServiceClient(cc grpc.ClientConnInterface) CandidateServiceClient {
+	return &candidateServiceClient{cc}
+}
+
+func (c *candidateServiceClient) CommissionUpdate(ctx context.Context, in *CommissionUpdateRequest, opts ...grpc.CallOption) (*CommissionUpdateResponse, error) {
+	out := new(CommissionUpdateResponse)
+	err := c.cc.Invoke(ctx, "/candidate_pb.CandidateService/CommissionUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateServiceClient) DelegationPolicy(ctx context.Context, in *DelegationPolicyRequest, opts ...grpc.CallOption) (*DelegationPolicyResponse, error) {
+	out := new(DelegationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/candidate_pb.CandidateService/DelegationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CandidateServiceServer is the server API for CandidateService service.
+type CandidateServiceServer interface {
+	CommissionUpdate(context.Context, *CommissionUpdateRequest) (*CommissionUpdateResponse, error)
+	DelegationPolicy(context.Context, *DelegationPolicyRequest) (*DelegationPolicyResponse, error)
+}
+
+// UnimplementedCandidateServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCandidateServiceServer struct {
+}
+
+func (*UnimplementedCandidateServiceServer) CommissionUpdate(ctx context.Context, req *CommissionUpdateRequest) (*CommissionUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionUpdate not implemented")
+}
+func (*UnimplementedCandidateServiceServer) DelegationPolicy(ctx context.Context, req *DelegationPolicyRequest) (*DelegationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationPolicy not implemented")
+}
+
+func RegisterCandidateServiceServer(s *grpc.Server, srv CandidateServiceServer) {
+	s.RegisterService(&_CandidateService_serviceDesc, srv)
+}
+
+func _CandidateService_CommissionUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommissionUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateServiceServer).CommissionUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/candidate_pb.CandidateService/CommissionUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateServiceServer).CommissionUpdate(ctx, req.(*CommissionUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateService_DelegationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateServiceServer).DelegationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/candidate_pb.CandidateService/DelegationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateServiceServer).DelegationPolicy(ctx, req.(*DelegationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CandidateService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "candidate_pb.CandidateService",
+	HandlerType: (*CandidateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CommissionUpdate",
+			Handler:    _CandidateService_CommissionUpdate_Handler,
+		},
+		{
+			MethodName: "DelegationPolicy",
+			Handler:    _CandidateService_DelegationPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "candidate.proto",
+}
@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: events.proto
+
+package events_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryEventsRequest struct {
+	// 0 means the last blocks allowed by the max range
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// 0 means the latest height
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// reward, slash or unbond
+	Type      []string `protobuf:"bytes,3,rep,name=type,proto3" json:"type,omitempty"`
+	Address   []string `protobuf:"bytes,4,rep,name=address,proto3" json:"address,omitempty"`
+	Validator []string `protobuf:"bytes,5,rep,name=validator,proto3" json:"validator,omitempty"`
+	Coin      []string `protobuf:"bytes,6,rep,name=coin,proto3" json:"coin,omitempty"`
+	Role      []string `protobuf:"bytes,7,rep,name=role,proto3" json:"role,omitempty"`
+	// fields to sum up amounts by: type, address, validator, coin or role
+	GroupBy              []string `protobuf:"bytes,8,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Page                 uint32   `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              uint32   `protobuf:"varint,10,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryEventsRequest) Reset()         { *m = QueryEventsRequest{} }
+func (m *QueryEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEventsRequest) ProtoMessage()    {}
+func (*QueryEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{0}
+}
+
+func (m *QueryEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEventsRequest.Unmarshal(m, b)
+}
+func (m *QueryEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventsRequest.Merge(m, src)
+}
+func (m *QueryEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryEventsRequest.Size(m)
+}
+func (m *QueryEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventsRequest proto.InternalMessageInfo
+
+func (m *QueryEventsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryEventsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryEventsRequest) GetType() []string {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *QueryEventsRequest) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *QueryEventsRequest) GetValidator() []string {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *QueryEventsRequest) GetCoin() []string {
+	if m != nil {
+		return m.Coin
+	}
+	return nil
+}
+
+func (m *QueryEventsRequest) GetRole() []string {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *QueryEventsRequest) GetGroupBy() []string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return nil
+}
+
+func (m *QueryEventsRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *QueryEventsRequest) GetPerPage() uint32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+type QueryEventsResponse struct {
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// number of all matched events
+	Total  uint64   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Events []*Event `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Groups []*Group `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	// number of all groups, up to 1000 of them are returned
+	TotalGroups          uint64   `protobuf:"varint,6,opt,name=total_groups,json=totalGroups,proto3" json:"total_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryEventsResponse) Reset()         { *m = QueryEventsResponse{} }
+func (m *QueryEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEventsResponse) ProtoMessage()    {}
+func (*QueryEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{1}
+}
+
+func (m *QueryEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEventsResponse.Unmarshal(m, b)
+}
+func (m *QueryEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventsResponse.Merge(m, src)
+}
+func (m *QueryEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryEventsResponse.Size(m)
+}
+func (m *QueryEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventsResponse proto.InternalMessageInfo
+
+func (m *QueryEventsResponse) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryEventsResponse) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryEventsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *QueryEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QueryEventsResponse) GetGroups() []*Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *QueryEventsResponse) GetTotalGroups() uint64 {
+	if m != nil {
+		return m.TotalGroups
+	}
+	return 0
+}
+
+type Event struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	ValidatorPubKey      string   `protobuf:"bytes,4,opt,name=validator_pub_key,json=validatorPubKey,proto3" json:"validator_pub_key,omitempty"`
+	Coin                 string   `protobuf:"bytes,5,opt,name=coin,proto3" json:"coin,omitempty"`
+	Role                 string   `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	Amount               string   `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{2}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Event) GetValidatorPubKey() string {
+	if m != nil {
+		return m.ValidatorPubKey
+	}
+	return ""
+}
+
+func (m *Event) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+func (m *Event) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Event) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// Group is a sum of amounts of events, fields which are not grouped by are empty
+type Group struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ValidatorPubKey      string   `protobuf:"bytes,3,opt,name=validator_pub_key,json=validatorPubKey,proto3" json:"validator_pub_key,omitempty"`
+	Coin                 string   `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin,omitempty"`
+	Role                 string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Count                uint64   `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Amount               string   `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Group) Reset()         { *m = Group{} }
+func (m *Group) String() string { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()    {}
+func (*Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{3}
+}
+
+func (m *Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Group.Unmarshal(m, b)
+}
+func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+}
+func (m *Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Group.Merge(m, src)
+}
+func (m *Group) XXX_Size() int {
+	return xxx_messageInfo_Group.Size(m)
+}
+func (m *Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Group proto.InternalMessageInfo
+
+func (m *Group) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Group) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Group) GetValidatorPubKey() string {
+	if m != nil {
+		return m.ValidatorPubKey
+	}
+	return ""
+}
+
+func (m *Group) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+func (m *Group) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Group) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Group) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryEventsRequest)(nil), "events_pb.QueryEventsRequest")
+	proto.RegisterType((*QueryEventsResponse)(nil), "events_pb.QueryEventsResponse")
+	proto.RegisterType((*Event)(nil), "events_pb.Event")
+	proto.RegisterType((*Group)(nil), "events_pb.Group")
+}
+
+func init() {
+	proto.RegisterFile("events.proto", fileDescriptor_8f22242cb04491f9)
+}
+
+var fileDescriptor_8f22242cb04491f9 = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0xe3, 0x9f, 0xc4, 0x93, 0x46, 0x85, 0xa5, 0xa0, 0xa5, 0x14, 0x08, 0x7e, 0x8a, 0x78,
+	0x48, 0xa4, 0x72, 0x03, 0x24, 0x04, 0x12, 0x2f, 0xc5, 0x1c, 0xc0, 0x5a, 0x27, 0x83, 0x6b, 0x91,
+	0x7a, 0xb7, 0xeb, 0x75, 0x24, 0xbf, 0x72, 0x02, 0x24, 0x0e, 0x83, 0x38, 0x07, 0x17, 0xe0, 0x81,
+	0x83, 0xa0, 0x9d, 0x75, 0x4c, 0xa2, 0xa6, 0x08, 0xa9, 0x6f, 0x33, 0xdf, 0xf7, 0x79, 0xf6, 0xdb,
+	0xf9, 0xd6, 0x70, 0x84, 0x1b, 0xac, 0x4c, 0x3d, 0x57, 0x5a, 0x1a, 0xc9, 0x62, 0xd7, 0x65, 0x2a,
+	0x3f, 0x3d, 0x2b, 0xa4, 0x2c, 0xd6, 0xb8, 0x10, 0xaa, 0x5c, 0x88, 0xaa, 0x92, 0x46, 0x98, 0x52,
+	0x56, 0x9d, 0x30, 0xf9, 0x3a, 0x00, 0xf6, 0xa1, 0x41, 0xdd, 0xbe, 0xa1, 0x0f, 0x52, 0xbc, 0x6e,
+	0xb0, 0x36, 0xec, 0x39, 0x8c, 0x3f, 0x69, 0x79, 0x95, 0x5d, 0x62, 0x59, 0x5c, 0x1a, 0xee, 0x4d,
+	0xbd, 0x59, 0x90, 0x82, 0x85, 0xde, 0x11, 0xc2, 0x9e, 0x40, 0x6c, 0xe4, 0x96, 0x1e, 0x10, 0x3d,
+	0x32, 0xb2, 0x23, 0x19, 0x04, 0xa6, 0x55, 0xc8, 0xfd, 0xa9, 0x3f, 0x8b, 0x53, 0xaa, 0x19, 0x87,
+	0xa1, 0x58, 0xad, 0x34, 0xd6, 0x35, 0x0f, 0x08, 0xde, 0xb6, 0xec, 0x0c, 0xe2, 0x8d, 0x58, 0x97,
+	0x2b, 0x61, 0xa4, 0xe6, 0x21, 0x71, 0x7f, 0x01, 0x3b, 0x6b, 0x29, 0xcb, 0x8a, 0x47, 0x6e, 0x96,
+	0xad, 0x2d, 0xa6, 0xe5, 0x1a, 0xf9, 0xd0, 0x61, 0xb6, 0x66, 0x8f, 0x61, 0x54, 0x68, 0xd9, 0xa8,
+	0x2c, 0x6f, 0xf9, 0xc8, 0x1d, 0x40, 0xfd, 0xeb, 0xd6, 0xca, 0x95, 0x28, 0x90, 0xc7, 0x53, 0x6f,
+	0x36, 0x49, 0xa9, 0xb6, 0x72, 0x85, 0x3a, 0x23, 0x1c, 0x08, 0x1f, 0x2a, 0xd4, 0x17, 0xa2, 0xc0,
+	0xe4, 0x97, 0x07, 0x0f, 0xf6, 0x56, 0x52, 0x2b, 0x59, 0xd5, 0x78, 0xc7, 0x9d, 0x9c, 0x40, 0x68,
+	0xa4, 0x11, 0x6b, 0xee, 0x13, 0xe1, 0x1a, 0x36, 0x83, 0xc8, 0x25, 0x45, 0x4b, 0x19, 0x9f, 0xdf,
+	0x9b, 0xf7, 0xc1, 0xcd, 0xe9, 0xf8, 0xb4, 0xe3, 0xad, 0x92, 0xee, 0x53, 0xf3, 0xf0, 0x86, 0xf2,
+	0xad, 0x25, 0xd2, 0x8e, 0x67, 0x2f, 0xe0, 0x88, 0x86, 0x67, 0x9d, 0x3e, 0xa2, 0x03, 0xc7, 0x84,
+	0x91, 0xb2, 0x4e, 0x7e, 0x78, 0x10, 0xd2, 0x78, 0xf6, 0x08, 0xa2, 0xbd, 0xfb, 0x74, 0x5d, 0x1f,
+	0xa1, 0xbd, 0xc6, 0x81, 0x08, 0x7d, 0x82, 0xb7, 0x2d, 0x7b, 0x09, 0xf7, 0xfb, 0xc4, 0x32, 0xd5,
+	0xe4, 0xd9, 0x67, 0x6c, 0x79, 0x40, 0x9a, 0xe3, 0x9e, 0xb8, 0x68, 0xf2, 0xf7, 0xd8, 0xf6, 0x81,
+	0x86, 0x6e, 0xf2, 0x5e, 0xa0, 0x91, 0xc3, 0x6c, 0x6d, 0x9d, 0x89, 0x2b, 0xd9, 0x54, 0x86, 0x0f,
+	0x09, 0xed, 0xba, 0xe4, 0xbb, 0x07, 0x21, 0x5d, 0xa3, 0xf7, 0xe8, 0x1d, 0xf6, 0x38, 0xf8, 0x0f,
+	0x8f, 0xfe, 0xbf, 0x3d, 0x06, 0x07, 0x3c, 0x86, 0x3b, 0x1e, 0x4f, 0x20, 0x5c, 0x92, 0x45, 0xb7,
+	0x63, 0xd7, 0xdc, 0xe6, 0xfc, 0xdc, 0xc0, 0xc4, 0x3d, 0xa9, 0x8f, 0xa8, 0x37, 0xe5, 0x12, 0xd9,
+	0x12, 0xc6, 0x3b, 0x0f, 0x8d, 0x3d, 0xdd, 0x89, 0xf4, 0xe6, 0x3f, 0x79, 0xfa, 0xec, 0x36, 0xda,
+	0xbd, 0xcf, 0xe4, 0xe1, 0x97, 0x9f, 0xbf, 0xbf, 0x0d, 0x8e, 0xd9, 0x64, 0x71, 0x6d, 0xd9, 0xcc,
+	0xa9, 0xf3, 0x88, 0x7e, 0xf4, 0x57, 0x7f, 0x06, 0x00, 0xde, 0x0d, 0x4f, 0x61, 0x21, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EventsServiceClient is the client API for EventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventsServiceClient interface {
+	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
+}
+
+type eventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsServiceClient(cc grpc.ClientConnInterface) EventsServiceClient {
+	return &eventsServiceClient{cc}
+}
+
+func (c *eventsServiceClient) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error) {
+	out := new(QueryEventsResponse)
+	err := c.cc.Invoke(ctx, "/events_pb.EventsService/QueryEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsServiceServer is the server API for EventsService service.
+type EventsServiceServer interface {
+	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
+}
+
+// UnimplementedEventsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEventsServiceServer struct {
+}
+
+func (*UnimplementedEventsServiceServer) QueryEvents(ctx context.Context, req *QueryEventsRequest) (*QueryEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
+}
+
+func RegisterEventsServiceServer(s *grpc.Server, srv EventsServiceServer) {
+	s.RegisterService(&_EventsService_serviceDesc, srv)
+}
+
+func _EventsService_QueryEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).QueryEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/events_pb.EventsService/QueryEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).QueryEvents(ctx, req.(*QueryEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "events_pb.EventsService",
+	HandlerType: (*EventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryEvents",
+			Handler:    _EventsService_QueryEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "events.proto",
+}
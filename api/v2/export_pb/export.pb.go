@@ -0,0 +1,721 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: export.proto
+
+package export_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ExportRequest struct {
+	// height of the state, 0 means the latest one
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// cursor of the last received item to resume the export after it, empty means from the beginning
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// maximum number of items, 0 means all
+	Limit                uint64   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa074eea61e559c, []int{0}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AccountItem struct {
+	Address              string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce                uint64     `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Balances             []*Balance `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty"`
+	Multisig             *Multisig  `protobuf:"bytes,4,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	Cursor               string     `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Height               uint64     `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AccountItem) Reset()         { *m = AccountItem{} }
+func (m *AccountItem) String() string { return proto.CompactTextString(m) }
+func (*AccountItem) ProtoMessage()    {}
+func (*AccountItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa074eea61e559c, []int{1}
+}
+
+func (m *AccountItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountItem.Unmarshal(m, b)
+}
+func (m *AccountItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountItem.Marshal(b, m, deterministic)
+}
+func (m *AccountItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountItem.Merge(m, src)
+}
+func (m *AccountItem) XXX_Size() int {
+	return xxx_messageInfo_AccountItem.Size(m)
+}
+func (m *AccountItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountItem proto.InternalMessageInfo
+
+func (m *AccountItem) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountItem) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountItem) GetBalances() []*Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *AccountItem) GetMultisig() *Multisig {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
+func (m *AccountItem) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *AccountItem) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type Balance struct {
+	Coin                 string   `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Balance) Reset()         { *m = Balance{} }
+func (m *Balance) String() string { return proto.CompactTextString(m) }
+func (*Balance) ProtoMessage()    {}
+func (*Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa074eea61e559c, []int{2}
+}
+
+func (m *Balance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Balance.Unmarshal(m, b)
+}
+func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+}
+func (m *Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Balance.Merge(m, src)
+}
+func (m *Balance) XXX_Size() int {
+	return xxx_messageInfo_Balance.Size(m)
+}
+func (m *Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Balance proto.InternalMessageInfo
+
+func (m *Balance) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+func (m *Balance) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type Multisig struct {
+	Threshold            uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Weights              []uint32 `protobuf:"varint,2,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	Addresses            []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Multisig) Reset()         { *m = Multisig{} }
+func (m *Multisig) String() string { return proto.CompactTextString(m) }
+func (*Multisig) ProtoMessage()    {}
+func (*Multisig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa074eea61e559c, []int{3}
+}
+
+func (m *Multisig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Multisig.Unmarshal(m, b)
+}
+func (m *Multisig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Multisig.Marshal(b, m, deterministic)
+}
+func (m *Multisig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Multisig.Merge(m, src)
+}
+func (m *Multisig) XXX_Size() int {
+	return xxx_messageInfo_Multisig.Size(m)
+}
+func (m *Multisig) XXX_DiscardUnknown() {
+	xxx_messageInfo_Multisig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Multisig proto.InternalMessageInfo
+
+func (m *Multisig) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Multisig) GetWeights() []uint32 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *Multisig) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type StakeItem struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Coin                 string   `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	BipValue             string   `protobuf:"bytes,5,opt,name=bip_value,json=bipValue,proto3" json:"bip_value,omitempty"`
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Height               uint64   `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeItem) Reset()         { *m = StakeItem{} }
+func (m *StakeItem) String() string { return proto.CompactTextString(m) }
+func (*StakeItem) ProtoMessage()    {}
+func (*StakeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa074eea61e559c, []int{4}
+}
+
+func (m *StakeItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeItem.Unmarshal(m, b)
+}
+func (m *StakeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeItem.Marshal(b, m, deterministic)
+}
+func (m *StakeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeItem.Merge(m, src)
+}
+func (m *StakeItem) XXX_Size() int {
+	return xxx_messageInfo_StakeItem.Size(m)
+}
+func (m *StakeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeItem proto.InternalMessageInfo
+
+func (m *StakeItem) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *StakeItem) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StakeItem) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+func (m *StakeItem) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *StakeItem) GetBipValue() string {
+	if m != nil {
+		return m.BipValue
+	}
+	return ""
+}
+
+func (m *StakeItem) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *StakeItem) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type FrozenFundItem struct {
+	// height of unfreezing
+	UnfreezeHeight uint64 `protobuf:"varint,1,opt,name=unfreeze_height,json=unfreezeHeight,proto3" json:"unfreeze_height,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// empty if the fund is not related to a candidate
+	PublicKey            string   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Coin                 string   `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin,omitempty"`
+	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Height               uint64   `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrozenFundItem) Reset()         { *m = FrozenFundItem{} }
+func (m *FrozenFundItem) String() string { return proto.CompactTextString(m) }
+func (*FrozenFundItem) ProtoMessage()    {}
+func (*FrozenFundItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa074eea61e559c, []int{5}
+}
+
+func (m *FrozenFundItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenFundItem.Unmarshal(m, b)
+}
+func (m *FrozenFundItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenFundItem.Marshal(b, m, deterministic)
+}
+func (m *FrozenFundItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenFundItem.Merge(m, src)
+}
+func (m *FrozenFundItem) XXX_Size() int {
+	return xxx_messageInfo_FrozenFundItem.Size(m)
+}
+func (m *FrozenFundItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenFundItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenFundItem proto.InternalMessageInfo
+
+func (m *FrozenFundItem) GetUnfreezeHeight() uint64 {
+	if m != nil {
+		return m.UnfreezeHeight
+	}
+	return 0
+}
+
+func (m *FrozenFundItem) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FrozenFundItem) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *FrozenFundItem) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+func (m *FrozenFundItem) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *FrozenFundItem) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *FrozenFundItem) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ExportRequest)(nil), "export_pb.ExportRequest")
+	proto.RegisterType((*AccountItem)(nil), "export_pb.AccountItem")
+	proto.RegisterType((*Balance)(nil), "export_pb.Balance")
+	proto.RegisterType((*Multisig)(nil), "export_pb.Multisig")
+	proto.RegisterType((*StakeItem)(nil), "export_pb.StakeItem")
+	proto.RegisterType((*FrozenFundItem)(nil), "export_pb.FrozenFundItem")
+}
+
+func init() {
+	proto.RegisterFile("export.proto", fileDescriptor_3aa074eea61e559c)
+}
+
+var fileDescriptor_3aa074eea61e559c = []byte{
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x8e, 0xd3, 0x30,
+	0x10, 0x95, 0x9b, 0xf4, 0x92, 0xe9, 0xb6, 0x08, 0xb3, 0x5a, 0x85, 0x9b, 0x54, 0xf5, 0x85, 0x3e,
+	0x95, 0xaa, 0xfb, 0x03, 0x80, 0x60, 0xc5, 0x45, 0xbc, 0xb8, 0x82, 0xd7, 0x92, 0xa4, 0xc3, 0xd6,
+	0xda, 0x34, 0x0e, 0x8e, 0xb3, 0xcb, 0xee, 0x8f, 0xf1, 0x13, 0x88, 0x9f, 0xe0, 0x47, 0x50, 0x6c,
+	0x27, 0x71, 0xd0, 0xb2, 0x12, 0x6f, 0x39, 0x73, 0xc6, 0xe3, 0x39, 0xc7, 0x33, 0x81, 0x23, 0xfc,
+	0x9e, 0x0b, 0xa9, 0x96, 0xb9, 0x14, 0x4a, 0xd0, 0xc0, 0xa0, 0x6d, 0x1e, 0xcf, 0x3f, 0xc1, 0xe4,
+	0x8d, 0x06, 0x0c, 0xbf, 0x95, 0x58, 0x28, 0x7a, 0x02, 0x83, 0x3d, 0xf2, 0xf3, 0xbd, 0x0a, 0xc9,
+	0x8c, 0x2c, 0x7c, 0x66, 0x51, 0x15, 0x4f, 0x4a, 0x59, 0x08, 0x19, 0xf6, 0x66, 0x64, 0x11, 0x30,
+	0x8b, 0xe8, 0x31, 0xf4, 0x53, 0x7e, 0xe0, 0x2a, 0xf4, 0x74, 0xba, 0x01, 0xf3, 0x5f, 0x04, 0xc6,
+	0x2f, 0x93, 0x44, 0x94, 0x99, 0x7a, 0xa7, 0xf0, 0x40, 0x43, 0x18, 0x46, 0xbb, 0x9d, 0xc4, 0xa2,
+	0xd0, 0x65, 0x03, 0x56, 0xc3, 0xea, 0x7c, 0x26, 0xb2, 0x04, 0x75, 0x59, 0x9f, 0x19, 0x40, 0x97,
+	0x30, 0x8a, 0xa3, 0x34, 0xca, 0x12, 0x2c, 0x42, 0x6f, 0xe6, 0x2d, 0xc6, 0x6b, 0xba, 0x6c, 0x9a,
+	0x5e, 0xbe, 0x32, 0x14, 0x6b, 0x72, 0xe8, 0x73, 0x18, 0x1d, 0xca, 0x54, 0xf1, 0x82, 0x9f, 0x87,
+	0xfe, 0x8c, 0x2c, 0xc6, 0xeb, 0x07, 0x4e, 0xfe, 0x47, 0x4b, 0xb1, 0x26, 0xc9, 0x91, 0xd3, 0xef,
+	0xc8, 0x69, 0xe5, 0x0f, 0x5c, 0xf9, 0xf3, 0x53, 0x18, 0xda, 0x5b, 0x29, 0x05, 0x3f, 0x11, 0x3c,
+	0xb3, 0x42, 0xf4, 0x77, 0xa5, 0xe2, 0x32, 0x4a, 0x4b, 0xb4, 0xe6, 0x18, 0x30, 0xff, 0x02, 0xa3,
+	0xfa, 0x6a, 0xfa, 0x04, 0x02, 0xb5, 0x97, 0x58, 0xec, 0x45, 0xba, 0xd3, 0x47, 0x27, 0xac, 0x0d,
+	0x54, 0xfe, 0x5c, 0xe9, 0x8b, 0x8a, 0xb0, 0x37, 0xf3, 0x16, 0x13, 0x56, 0xc3, 0xea, 0x9c, 0xb5,
+	0xca, 0x5a, 0x11, 0xb0, 0x36, 0x30, 0xff, 0x41, 0x20, 0xd8, 0xa8, 0xe8, 0x02, 0xb5, 0xcb, 0x4f,
+	0x01, 0xf2, 0x32, 0x4e, 0x79, 0xb2, 0xbd, 0xc0, 0x6b, 0xdb, 0x5f, 0x60, 0x22, 0x1f, 0xf0, 0xba,
+	0x6a, 0x52, 0x5c, 0x65, 0x58, 0xbf, 0xa0, 0x01, 0x8d, 0x1c, 0xef, 0x36, 0x39, 0xbe, 0x23, 0x87,
+	0x3e, 0x86, 0x20, 0xe6, 0xf9, 0xd6, 0x30, 0xc6, 0xb6, 0x51, 0xcc, 0xf3, 0xcf, 0x9a, 0x6c, 0x0d,
+	0x1d, 0xfc, 0xc3, 0xd0, 0x61, 0xc7, 0xd0, 0x9f, 0x04, 0xa6, 0x67, 0x52, 0xdc, 0x60, 0x76, 0x56,
+	0x66, 0x3b, 0xdd, 0xfe, 0x33, 0xb8, 0x57, 0x66, 0x5f, 0x25, 0xe2, 0x0d, 0x6e, 0x3b, 0x33, 0x38,
+	0xad, 0xc3, 0x6f, 0x75, 0xd4, 0x9d, 0xa6, 0x5e, 0x77, 0x9a, 0xba, 0x0e, 0x78, 0x7f, 0x3b, 0x50,
+	0x6b, 0xf5, 0x6f, 0xd3, 0xda, 0x77, 0xb5, 0xfe, 0xa7, 0x9c, 0xf5, 0x6f, 0x52, 0x2f, 0xd2, 0x06,
+	0xe5, 0x25, 0x4f, 0x90, 0xbe, 0x86, 0xe9, 0x46, 0x49, 0x8c, 0x0e, 0x76, 0x0f, 0x0a, 0x1a, 0x3a,
+	0x23, 0xd9, 0x59, 0xba, 0x47, 0x27, 0x0e, 0xe3, 0xac, 0xcd, 0x8a, 0xd0, 0x17, 0x70, 0x64, 0xaa,
+	0xe8, 0x57, 0xbe, 0xab, 0xc6, 0xb1, 0xc3, 0x34, 0x23, 0xb1, 0x22, 0xf4, 0x3d, 0xdc, 0x37, 0x15,
+	0x5a, 0xb7, 0xef, 0x2a, 0xf3, 0xd0, 0x61, 0xba, 0xef, 0xb3, 0x22, 0xf1, 0x40, 0xff, 0x3f, 0x4e,
+	0xff, 0x0c, 0x00, 0x6b, 0xd5, 0x99, 0x2d, 0x4f, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ExportServiceClient is the client API for ExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExportServiceClient interface {
+	StreamAccounts(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StreamAccountsClient, error)
+	StreamStakes(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StreamStakesClient, error)
+	StreamFrozenFunds(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StreamFrozenFundsClient, error)
+}
+
+type exportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportServiceClient(cc grpc.ClientConnInterface) ExportServiceClient {
+	return &exportServiceClient{cc}
+}
+
+func (c *exportServiceClient) StreamAccounts(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StreamAccountsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExportService_serviceDesc.Streams[0], "/export_pb.ExportService/StreamAccounts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceStreamAccountsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_StreamAccountsClient interface {
+	Recv() (*AccountItem, error)
+	grpc.ClientStream
+}
+
+type exportServiceStreamAccountsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceStreamAccountsClient) Recv() (*AccountItem, error) {
+	m := new(AccountItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) StreamStakes(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StreamStakesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExportService_serviceDesc.Streams[1], "/export_pb.ExportService/StreamStakes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceStreamStakesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_StreamStakesClient interface {
+	Recv() (*StakeItem, error)
+	grpc.ClientStream
+}
+
+type exportServiceStreamStakesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceStreamStakesClient) Recv() (*StakeItem, error) {
+	m := new(StakeItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) StreamFrozenFunds(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StreamFrozenFundsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExportService_serviceDesc.Streams[2], "/export_pb.ExportService/StreamFrozenFunds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceStreamFrozenFundsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_StreamFrozenFundsClient interface {
+	Recv() (*FrozenFundItem, error)
+	grpc.ClientStream
+}
+
+type exportServiceStreamFrozenFundsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceStreamFrozenFundsClient) Recv() (*FrozenFundItem, error) {
+	m := new(FrozenFundItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExportServiceServer is the server API for ExportService service.
+type ExportServiceServer interface {
+	StreamAccounts(*ExportRequest, ExportService_StreamAccountsServer) error
+	StreamStakes(*ExportRequest, ExportService_StreamStakesServer) error
+	StreamFrozenFunds(*ExportRequest, ExportService_StreamFrozenFundsServer) error
+}
+
+// UnimplementedExportServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedExportServiceServer struct {
+}
+
+func (*UnimplementedExportServiceServer) StreamAccounts(req *ExportRequest, srv ExportService_StreamAccountsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccounts not implemented")
+}
+func (*UnimplementedExportServiceServer) StreamStakes(req *ExportRequest, srv ExportService_StreamStakesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStakes not implemented")
+}
+func (*UnimplementedExportServiceServer) StreamFrozenFunds(req *ExportRequest, srv ExportService_StreamFrozenFundsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFrozenFunds not implemented")
+}
+
+func RegisterExportServiceServer(s *grpc.Server, srv ExportServiceServer) {
+	s.RegisterService(&_ExportService_serviceDesc, srv)
+}
+
+func _ExportService_StreamAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).StreamAccounts(m, &exportServiceStreamAccountsServer{stream})
+}
+
+type ExportService_StreamAccountsServer interface {
+	Send(*AccountItem) error
+	grpc.ServerStream
+}
+
+type exportServiceStreamAccountsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceStreamAccountsServer) Send(m *AccountItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_StreamStakes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).StreamStakes(m, &exportServiceStreamStakesServer{stream})
+}
+
+type ExportService_StreamStakesServer interface {
+	Send(*StakeItem) error
+	grpc.ServerStream
+}
+
+type exportServiceStreamStakesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceStreamStakesServer) Send(m *StakeItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_StreamFrozenFunds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).StreamFrozenFunds(m, &exportServiceStreamFrozenFundsServer{stream})
+}
+
+type ExportService_StreamFrozenFundsServer interface {
+	Send(*FrozenFundItem) error
+	grpc.ServerStream
+}
+
+type exportServiceStreamFrozenFundsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceStreamFrozenFundsServer) Send(m *FrozenFundItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ExportService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "export_pb.ExportService",
+	HandlerType: (*ExportServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAccounts",
+			Handler:       _ExportService_StreamAccounts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamStakes",
+			Handler:       _ExportService_StreamStakes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFrozenFunds",
+			Handler:       _ExportService_StreamFrozenFunds_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "export.proto",
+}
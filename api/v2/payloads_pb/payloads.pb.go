@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: payloads.proto
+
+package payloads_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	api_pb "github.com/MinterTeam/node-grpc-gateway/api_pb"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type TransactionsByPayloadRequest struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// payload or service_data, payload by default
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// search transactions whose field starts with the value
+	Prefix               bool     `protobuf:"varint,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              uint32   `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionsByPayloadRequest) Reset()         { *m = TransactionsByPayloadRequest{} }
+func (m *TransactionsByPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionsByPayloadRequest) ProtoMessage()    {}
+func (*TransactionsByPayloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a075f58f70088c8, []int{0}
+}
+
+func (m *TransactionsByPayloadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionsByPayloadRequest.Unmarshal(m, b)
+}
+func (m *TransactionsByPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionsByPayloadRequest.Marshal(b, m, deterministic)
+}
+func (m *TransactionsByPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionsByPayloadRequest.Merge(m, src)
+}
+func (m *TransactionsByPayloadRequest) XXX_Size() int {
+	return xxx_messageInfo_TransactionsByPayloadRequest.Size(m)
+}
+func (m *TransactionsByPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionsByPayloadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionsByPayloadRequest proto.InternalMessageInfo
+
+func (m *TransactionsByPayloadRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionsByPayloadRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TransactionsByPayloadRequest) GetPrefix() bool {
+	if m != nil {
+		return m.Prefix
+	}
+	return false
+}
+
+func (m *TransactionsByPayloadRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *TransactionsByPayloadRequest) GetPerPage() uint32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TransactionsByPayloadRequest)(nil), "payloads_pb.TransactionsByPayloadRequest")
+}
+
+func init() {
+	proto.RegisterFile("payloads.proto", fileDescriptor_3a075f58f70088c8)
+}
+
+var fileDescriptor_3a075f58f70088c8 = []byte{
+	// 256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xd1, 0x4a, 0xc3, 0x30,
+	0x14, 0x86, 0xc9, 0xdc, 0xe6, 0x16, 0x51, 0x21, 0xa8, 0xc4, 0xd2, 0x8b, 0xb2, 0xab, 0x7a, 0xd3,
+	0x82, 0xbe, 0x81, 0x4f, 0x30, 0xa2, 0xf7, 0xe5, 0x74, 0x3b, 0x2b, 0x81, 0x92, 0x1c, 0x93, 0x6c,
+	0xd8, 0xdb, 0xbd, 0x81, 0x08, 0xbe, 0x98, 0xaf, 0xe0, 0x83, 0x88, 0x69, 0x85, 0x21, 0xb2, 0xbb,
+	0xf3, 0x7d, 0xf9, 0x49, 0xce, 0x1f, 0x7e, 0x41, 0xd0, 0xb5, 0x16, 0xd6, 0xbe, 0x20, 0x67, 0x83,
+	0x15, 0x67, 0xbf, 0x5c, 0x51, 0x9d, 0xa4, 0x8d, 0xb5, 0x4d, 0x8b, 0x25, 0x90, 0x2e, 0xc1, 0x18,
+	0x1b, 0x20, 0x68, 0x6b, 0x86, 0x68, 0x32, 0x07, 0xd2, 0xfd, 0xb8, 0x78, 0x63, 0x3c, 0x7d, 0x76,
+	0x60, 0x3c, 0xac, 0x62, 0xe2, 0xb1, 0x5b, 0xf6, 0xd7, 0x28, 0x7c, 0xd9, 0xa2, 0x0f, 0xe2, 0x8a,
+	0x4f, 0x76, 0xd0, 0x6e, 0x51, 0xb2, 0x8c, 0xe5, 0x73, 0xd5, 0xc3, 0x8f, 0xdd, 0x68, 0x6c, 0xd7,
+	0x72, 0xd4, 0xdb, 0x08, 0xe2, 0x86, 0x4f, 0xc9, 0xe1, 0x46, 0xbf, 0xca, 0x93, 0x8c, 0xe5, 0x33,
+	0x35, 0x90, 0x10, 0x7c, 0x4c, 0xd0, 0xa0, 0x1c, 0x67, 0x2c, 0x3f, 0x57, 0x71, 0x16, 0xb7, 0x7c,
+	0x46, 0xe8, 0xaa, 0xe8, 0x27, 0xd1, 0x9f, 0x12, 0xba, 0x25, 0x34, 0x78, 0xff, 0xc1, 0xf8, 0xe5,
+	0xb0, 0x85, 0x7f, 0x42, 0xb7, 0xd3, 0x2b, 0x14, 0x7b, 0xc6, 0xaf, 0xff, 0xdd, 0x53, 0xdc, 0x15,
+	0x07, 0xc5, 0x8b, 0x63, 0x5d, 0x92, 0xb4, 0x00, 0xd2, 0x7f, 0x53, 0x0a, 0x3d, 0x59, 0xe3, 0x71,
+	0x91, 0xed, 0x3f, 0xbf, 0xde, 0x47, 0x89, 0x90, 0x65, 0x38, 0x38, 0xae, 0xea, 0xae, 0x1a, 0x1e,
+	0xa8, 0xa7, 0xf1, 0xcf, 0x1e, 0xbe, 0x07, 0x00, 0x61, 0x29, 0x01, 0x2e, 0x7b, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PayloadsServiceClient is the client API for PayloadsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PayloadsServiceClient interface {
+	TransactionsByPayload(ctx context.Context, in *TransactionsByPayloadRequest, opts ...grpc.CallOption) (*api_pb.TransactionsResponse, error)
+}
+
+type payloadsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayloadsServiceClient(cc grpc.ClientConnInterface) PayloadsServiceClient {
+	return &payloadsServiceClient{cc}
+}
+
+func (c *payloadsServiceClient) TransactionsByPayload(ctx context.Context, in *TransactionsByPayloadRequest, opts ...grpc.CallOption) (*api_pb.TransactionsResponse, error) {
+	out := new(api_pb.TransactionsResponse)
+	err := c.cc.Invoke(ctx, "/payloads_pb.PayloadsService/TransactionsByPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayloadsServiceServer is the server API for PayloadsService service.
+type PayloadsServiceServer interface {
+	TransactionsByPayload(context.Context, *TransactionsByPayloadRequest) (*api_pb.TransactionsResponse, error)
+}
+
+// UnimplementedPayloadsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPayloadsServiceServer struct {
+}
+
+func (*UnimplementedPayloadsServiceServer) TransactionsByPayload(ctx context.Context, req *TransactionsByPayloadRequest) (*api_pb.TransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionsByPayload not implemented")
+}
+
+func RegisterPayloadsServiceServer(s *grpc.Server, srv PayloadsServiceServer) {
+	s.RegisterService(&_PayloadsService_serviceDesc, srv)
+}
+
+func _PayloadsService_TransactionsByPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionsByPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadsServiceServer).TransactionsByPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/payloads_pb.PayloadsService/TransactionsByPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadsServiceServer).TransactionsByPayload(ctx, req.(*TransactionsByPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PayloadsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "payloads_pb.PayloadsService",
+	HandlerType: (*PayloadsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransactionsByPayload",
+			Handler:    _PayloadsService_TransactionsByPayload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payloads.proto",
+}
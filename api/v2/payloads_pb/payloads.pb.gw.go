@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: payloads.proto
+
+/*
+Package payloads_pb is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package payloads_pb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+// Suppress "imported and not used" errors
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+var _ = descriptor.ForMessage
+
+var (
+	filter_PayloadsService_TransactionsByPayload_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_PayloadsService_TransactionsByPayload_0(ctx context.Context, marshaler runtime.Marshaler, client PayloadsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq TransactionsByPayloadRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_PayloadsService_TransactionsByPayload_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.TransactionsByPayload(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_PayloadsService_TransactionsByPayload_0(ctx context.Context, marshaler runtime.Marshaler, server PayloadsServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq TransactionsByPayloadRequest
+	var metadata runtime.ServerMetadata
+
+	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_PayloadsService_TransactionsByPayload_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.TransactionsByPayload(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+// RegisterPayloadsServiceHandlerServer registers the http handlers for service PayloadsService to "mux".
+// UnaryRPC     :call PayloadsServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+func RegisterPayloadsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server PayloadsServiceServer) error {
+
+	mux.Handle("GET", pattern_PayloadsService_TransactionsByPayload_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_PayloadsService_TransactionsByPayload_0(rctx, inboundMarshaler, server, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_PayloadsService_TransactionsByPayload_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+// RegisterPayloadsServiceHandlerFromEndpoint is same as RegisterPayloadsServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterPayloadsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterPayloadsServiceHandler(ctx, mux, conn)
+}
+
+// RegisterPayloadsServiceHandler registers the http handlers for service PayloadsService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterPayloadsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterPayloadsServiceHandlerClient(ctx, mux, NewPayloadsServiceClient(conn))
+}
+
+// RegisterPayloadsServiceHandlerClient registers the http handlers for service PayloadsService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "PayloadsServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "PayloadsServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "PayloadsServiceClient" to call the correct interceptors.
+func RegisterPayloadsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client PayloadsServiceClient) error {
+
+	mux.Handle("GET", pattern_PayloadsService_TransactionsByPayload_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_PayloadsService_TransactionsByPayload_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_PayloadsService_TransactionsByPayload_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_PayloadsService_TransactionsByPayload_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"transactions_by_payload"}, "", runtime.AssumeColonVerbOpt(true)))
+)
+
+var (
+	forward_PayloadsService_TransactionsByPayload_0 = runtime.ForwardResponseMessage
+)
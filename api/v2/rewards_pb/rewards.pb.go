@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rewards.proto
+
+package rewards_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type RewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// first height of the range, 0 means the last blocks allowed by the max range
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// last height of the range, 0 means the latest one
+	ToHeight uint64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// RFC3339 times instead of heights
+	FromTime             string   `protobuf:"bytes,4,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime               string   `protobuf:"bytes,5,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RewardsRequest) Reset()         { *m = RewardsRequest{} }
+func (m *RewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*RewardsRequest) ProtoMessage()    {}
+func (*RewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c90f86cd3b969e76, []int{0}
+}
+
+func (m *RewardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RewardsRequest.Unmarshal(m, b)
+}
+func (m *RewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RewardsRequest.Marshal(b, m, deterministic)
+}
+func (m *RewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsRequest.Merge(m, src)
+}
+func (m *RewardsRequest) XXX_Size() int {
+	return xxx_messageInfo_RewardsRequest.Size(m)
+}
+func (m *RewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsRequest proto.InternalMessageInfo
+
+func (m *RewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *RewardsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *RewardsRequest) GetFromTime() string {
+	if m != nil {
+		return m.FromTime
+	}
+	return ""
+}
+
+func (m *RewardsRequest) GetToTime() string {
+	if m != nil {
+		return m.ToTime
+	}
+	return ""
+}
+
+type RewardsResponse struct {
+	Address              string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	FromHeight           uint64             `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight             uint64             `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Total                string             `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Candidates           []*CandidateReward `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RewardsResponse) Reset()         { *m = RewardsResponse{} }
+func (m *RewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*RewardsResponse) ProtoMessage()    {}
+func (*RewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c90f86cd3b969e76, []int{1}
+}
+
+func (m *RewardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RewardsResponse.Unmarshal(m, b)
+}
+func (m *RewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RewardsResponse.Marshal(b, m, deterministic)
+}
+func (m *RewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsResponse.Merge(m, src)
+}
+func (m *RewardsResponse) XXX_Size() int {
+	return xxx_messageInfo_RewardsResponse.Size(m)
+}
+func (m *RewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsResponse proto.InternalMessageInfo
+
+func (m *RewardsResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardsResponse) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *RewardsResponse) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *RewardsResponse) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *RewardsResponse) GetCandidates() []*CandidateReward {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type CandidateReward struct {
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Total     string `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	// amounts by role of the address: Validator, Delegator, DAO or Developers
+	Roles                map[string]string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CandidateReward) Reset()         { *m = CandidateReward{} }
+func (m *CandidateReward) String() string { return proto.CompactTextString(m) }
+func (*CandidateReward) ProtoMessage()    {}
+func (*CandidateReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c90f86cd3b969e76, []int{2}
+}
+
+func (m *CandidateReward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateReward.Unmarshal(m, b)
+}
+func (m *CandidateReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateReward.Marshal(b, m, deterministic)
+}
+func (m *CandidateReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateReward.Merge(m, src)
+}
+func (m *CandidateReward) XXX_Size() int {
+	return xxx_messageInfo_CandidateReward.Size(m)
+}
+func (m *CandidateReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateReward proto.InternalMessageInfo
+
+func (m *CandidateReward) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *CandidateReward) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *CandidateReward) GetRoles() map[string]string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type APRRequest struct {
+	// height of the state, 0 means the latest one
+	Height int32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// candidate to estimate for, empty means all candidates
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APRRequest) Reset()         { *m = APRRequest{} }
+func (m *APRRequest) String() string { return proto.CompactTextString(m) }
+func (*APRRequest) ProtoMessage()    {}
+func (*APRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c90f86cd3b969e76, []int{3}
+}
+
+func (m *APRRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APRRequest.Unmarshal(m, b)
+}
+func (m *APRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APRRequest.Marshal(b, m, deterministic)
+}
+func (m *APRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APRRequest.Merge(m, src)
+}
+func (m *APRRequest) XXX_Size() int {
+	return xxx_messageInfo_APRRequest.Size(m)
+}
+func (m *APRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_APRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APRRequest proto.InternalMessageInfo
+
+func (m *APRRequest) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *APRRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type APRResponse struct {
+	Height               uint64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	RewardPerBlock       string          `protobuf:"bytes,2,opt,name=reward_per_block,json=rewardPerBlock,proto3" json:"reward_per_block,omitempty"`
+	BlockTime            string          `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	BlocksPerYear        uint64          `protobuf:"varint,4,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	TotalStake           string          `protobuf:"bytes,5,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`
+	Candidates           []*CandidateAPR `protobuf:"bytes,6,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *APRResponse) Reset()         { *m = APRResponse{} }
+func (m *APRResponse) String() string { return proto.CompactTextString(m) }
+func (*APRResponse) ProtoMessage()    {}
+func (*APRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c90f86cd3b969e76, []int{4}
+}
+
+func (m *APRResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APRResponse.Unmarshal(m, b)
+}
+func (m *APRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APRResponse.Marshal(b, m, deterministic)
+}
+func (m *APRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APRResponse.Merge(m, src)
+}
+func (m *APRResponse) XXX_Size() int {
+	return xxx_messageInfo_APRResponse.Size(m)
+}
+func (m *APRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_APRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APRResponse proto.InternalMessageInfo
+
+func (m *APRResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *APRResponse) GetRewardPerBlock() string {
+	if m != nil {
+		return m.RewardPerBlock
+	}
+	return ""
+}
+
+func (m *APRResponse) GetBlockTime() string {
+	if m != nil {
+		return m.BlockTime
+	}
+	return ""
+}
+
+func (m *APRResponse) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
+}
+
+func (m *APRResponse) GetTotalStake() string {
+	if m != nil {
+		return m.TotalStake
+	}
+	return ""
+}
+
+func (m *APRResponse) GetCandidates() []*CandidateAPR {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type CandidateAPR struct {
+	PublicKey  string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Commission uint32 `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	Validator  bool   `protobuf:"varint,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	Stake      string `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	// share of the stake in total stake of validators, percent
+	StakeShare string `protobuf:"bytes,5,opt,name=stake_share,json=stakeShare,proto3" json:"stake_share,omitempty"`
+	// percent
+	Apr                  string   `protobuf:"bytes,6,opt,name=apr,proto3" json:"apr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateAPR) Reset()         { *m = CandidateAPR{} }
+func (m *CandidateAPR) String() string { return proto.CompactTextString(m) }
+func (*CandidateAPR) ProtoMessage()    {}
+func (*CandidateAPR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c90f86cd3b969e76, []int{5}
+}
+
+func (m *CandidateAPR) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateAPR.Unmarshal(m, b)
+}
+func (m *CandidateAPR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateAPR.Marshal(b, m, deterministic)
+}
+func (m *CandidateAPR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateAPR.Merge(m, src)
+}
+func (m *CandidateAPR) XXX_Size() int {
+	return xxx_messageInfo_CandidateAPR.Size(m)
+}
+func (m *CandidateAPR) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateAPR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateAPR proto.InternalMessageInfo
+
+func (m *CandidateAPR) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *CandidateAPR) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *CandidateAPR) GetValidator() bool {
+	if m != nil {
+		return m.Validator
+	}
+	return false
+}
+
+func (m *CandidateAPR) GetStake() string {
+	if m != nil {
+		return m.Stake
+	}
+	return ""
+}
+
+func (m *CandidateAPR) GetStakeShare() string {
+	if m != nil {
+		return m.StakeShare
+	}
+	return ""
+}
+
+func (m *CandidateAPR) GetApr() string {
+	if m != nil {
+		return m.Apr
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RewardsRequest)(nil), "rewards_pb.RewardsRequest")
+	proto.RegisterType((*RewardsResponse)(nil), "rewards_pb.RewardsResponse")
+	proto.RegisterType((*CandidateReward)(nil), "rewards_pb.CandidateReward")
+	proto.RegisterMapType((map[string]string)(nil), "rewards_pb.CandidateReward.RolesEntry")
+	proto.RegisterType((*APRRequest)(nil), "rewards_pb.APRRequest")
+	proto.RegisterType((*APRResponse)(nil), "rewards_pb.APRResponse")
+	proto.RegisterType((*CandidateAPR)(nil), "rewards_pb.CandidateAPR")
+}
+
+func init() {
+	proto.RegisterFile("rewards.proto", fileDescriptor_c90f86cd3b969e76)
+}
+
+var fileDescriptor_c90f86cd3b969e76 = []byte{
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0xe3, 0xc4, 0x6d, 0xa6, 0xbf, 0x5a, 0x55, 0xad, 0xe5, 0x16, 0xa8, 0x7c, 0xa8, 0x72,
+	0x4a, 0xa4, 0x72, 0xa9, 0x80, 0x0b, 0x14, 0x24, 0x24, 0x2e, 0xd5, 0x86, 0x0b, 0x17, 0xac, 0x4d,
+	0xb2, 0x24, 0x56, 0x1c, 0xaf, 0xd9, 0xdd, 0x04, 0x45, 0x88, 0x0b, 0xaf, 0xc0, 0x9d, 0xa7, 0x40,
+	0x9c, 0x10, 0x2f, 0xc1, 0x2b, 0xf0, 0x02, 0xbc, 0x01, 0xda, 0xd9, 0x75, 0xec, 0x84, 0x0a, 0x4e,
+	0xdc, 0x32, 0xdf, 0x37, 0x3b, 0x33, 0xdf, 0xcc, 0x17, 0xc3, 0x9e, 0xe4, 0xef, 0x98, 0x1c, 0xa9,
+	0x6e, 0x21, 0x85, 0x16, 0x04, 0x5c, 0x98, 0x14, 0x83, 0xe8, 0x6c, 0x2c, 0xc4, 0x38, 0xe3, 0x3d,
+	0x56, 0xa4, 0x3d, 0x96, 0xe7, 0x42, 0x33, 0x9d, 0x8a, 0xdc, 0x65, 0xc6, 0x9f, 0x3d, 0xd8, 0xa7,
+	0x36, 0x99, 0xf2, 0xb7, 0x73, 0xae, 0x34, 0x09, 0x61, 0x8b, 0x8d, 0x46, 0x92, 0x2b, 0x15, 0x7a,
+	0xe7, 0x5e, 0xa7, 0x4d, 0xcb, 0x90, 0xdc, 0x83, 0x9d, 0x37, 0x52, 0xcc, 0x92, 0x09, 0x4f, 0xc7,
+	0x13, 0x1d, 0x36, 0xce, 0xbd, 0x4e, 0x93, 0x82, 0x81, 0x9e, 0x23, 0x42, 0x4e, 0xa1, 0xad, 0x45,
+	0x49, 0xfb, 0x48, 0x6f, 0x6b, 0x51, 0x91, 0xf8, 0x5a, 0xa7, 0x33, 0x1e, 0x36, 0xb1, 0xf2, 0xb6,
+	0x01, 0x5e, 0xa6, 0x33, 0x4e, 0x4e, 0x60, 0x4b, 0x0b, 0x4b, 0xb5, 0x90, 0x0a, 0xb4, 0x30, 0x44,
+	0xfc, 0xcd, 0x83, 0x83, 0xd5, 0x80, 0xaa, 0x10, 0xb9, 0xe2, 0xff, 0x6d, 0xc2, 0x23, 0x68, 0x69,
+	0xa1, 0x59, 0xe6, 0xa6, 0xb3, 0x01, 0x79, 0x08, 0x30, 0x64, 0xf9, 0x28, 0x1d, 0x31, 0xcd, 0x55,
+	0xd8, 0x3a, 0xf7, 0x3b, 0x3b, 0x97, 0xa7, 0xdd, 0x6a, 0xc3, 0xdd, 0xeb, 0x92, 0xb5, 0x73, 0xd2,
+	0x5a, 0x7a, 0xfc, 0xdd, 0x83, 0x83, 0x0d, 0x9e, 0xdc, 0x01, 0x28, 0xe6, 0x83, 0x2c, 0x1d, 0x26,
+	0x53, 0xbe, 0x74, 0x0a, 0xda, 0x16, 0x79, 0xc1, 0x97, 0xd5, 0x14, 0x8d, 0xfa, 0x14, 0x8f, 0xa0,
+	0x25, 0x45, 0xc6, 0x55, 0xe8, 0xe3, 0x00, 0x17, 0x7f, 0x19, 0xa0, 0x4b, 0x4d, 0xe2, 0xb3, 0x5c,
+	0xcb, 0x25, 0xb5, 0x8f, 0xa2, 0x2b, 0x80, 0x0a, 0x24, 0x87, 0xe0, 0x57, 0x9d, 0xfd, 0xa9, 0xed,
+	0xb9, 0x60, 0xd9, 0x9c, 0x97, 0x3d, 0x31, 0x78, 0xd0, 0xb8, 0xf2, 0xe2, 0x6b, 0x80, 0xc7, 0x37,
+	0xb4, 0xf4, 0xc6, 0x31, 0x04, 0x6e, 0x77, 0xe6, 0x71, 0x8b, 0xba, 0x68, 0x43, 0x52, 0x63, 0x43,
+	0x52, 0xfc, 0xcb, 0x83, 0x1d, 0xac, 0xe2, 0x0e, 0xb8, 0x5e, 0xa6, 0xb9, 0x2a, 0xd3, 0x81, 0x43,
+	0x2b, 0x2b, 0x29, 0xb8, 0x4c, 0x06, 0x99, 0x18, 0x4e, 0x5d, 0xb1, 0x7d, 0x8b, 0xdf, 0x70, 0xf9,
+	0xc4, 0xa0, 0xa6, 0x21, 0xd2, 0xd6, 0x32, 0xbe, 0x6d, 0x88, 0x08, 0xda, 0xe9, 0x02, 0x0e, 0x30,
+	0x50, 0x58, 0x68, 0xc9, 0x99, 0xc4, 0x9b, 0x36, 0xe9, 0x9e, 0x85, 0x6f, 0xb8, 0x7c, 0xc5, 0x99,
+	0x34, 0x7e, 0xc1, 0xf5, 0x26, 0x4a, 0xb3, 0x69, 0x69, 0x3d, 0x40, 0xa8, 0x6f, 0x10, 0x72, 0xb5,
+	0x76, 0xfc, 0x00, 0x77, 0x1f, 0xde, 0xba, 0x7b, 0xa3, 0xaf, 0x7e, 0xf9, 0x2f, 0x1e, 0xec, 0xd6,
+	0xc9, 0x7f, 0x9d, 0xfd, 0x2e, 0xc0, 0x50, 0xcc, 0x66, 0xa9, 0x52, 0xa9, 0xc8, 0x51, 0xf5, 0x1e,
+	0xad, 0x21, 0xe4, 0x0c, 0xda, 0x0b, 0x96, 0x99, 0x6a, 0x42, 0xa2, 0xe0, 0x6d, 0x5a, 0x01, 0xe6,
+	0x80, 0x56, 0x82, 0xb3, 0x2e, 0x06, 0x46, 0x1e, 0xfe, 0x48, 0xd4, 0x84, 0xc9, 0x95, 0x3c, 0x84,
+	0xfa, 0x06, 0x31, 0x4e, 0x60, 0x85, 0x0c, 0x03, 0xeb, 0x04, 0x56, 0xc8, 0xcb, 0xaf, 0xd5, 0x07,
+	0xa1, 0xcf, 0xe5, 0x22, 0x1d, 0x72, 0xf2, 0x1a, 0xb6, 0x1c, 0x42, 0xa2, 0xba, 0xf4, 0xf5, 0xef,
+	0x46, 0x74, 0x7a, 0x2b, 0x67, 0x2f, 0x1e, 0x47, 0x1f, 0x7f, 0xfc, 0xfc, 0xd4, 0x38, 0x22, 0xa4,
+	0xe7, 0x92, 0x7a, 0xef, 0xdd, 0x7f, 0xf6, 0x03, 0x79, 0x0a, 0xbe, 0xd9, 0xcf, 0x71, 0xfd, 0x7d,
+	0xe5, 0xb9, 0xe8, 0xe4, 0x0f, 0xdc, 0xd5, 0xdc, 0xc5, 0x9a, 0x01, 0x69, 0xf6, 0x58, 0x21, 0x07,
+	0x01, 0x7e, 0xd0, 0xee, 0xff, 0x1e, 0x00, 0xdf, 0x18, 0xb0, 0xe9, 0x0b, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RewardsServiceClient is the client API for RewardsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RewardsServiceClient interface {
+	Rewards(ctx context.Context, in *RewardsRequest, opts ...grpc.CallOption) (*RewardsResponse, error)
+	APR(ctx context.Context, in *APRRequest, opts ...grpc.CallOption) (*APRResponse, error)
+}
+
+type rewardsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRewardsServiceClient(cc grpc.ClientConnInterface) RewardsServiceClient {
+	return &rewardsServiceClient{cc}
+}
+
+func (c *rewardsServiceClient) Rewards(ctx context.Context, in *RewardsRequest, opts ...grpc.CallOption) (*RewardsResponse, error) {
+	out := new(RewardsResponse)
+	err := c.cc.Invoke(ctx, "/rewards_pb.RewardsService/Rewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardsServiceClient) APR(ctx context.Context, in *APRRequest, opts ...grpc.CallOption) (*APRResponse, error) {
+	out := new(APRResponse)
+	err := c.cc.Invoke(ctx, "/rewards_pb.RewardsService/APR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RewardsServiceServer is the server API for RewardsService service.
+type RewardsServiceServer interface {
+	Rewards(context.Context, *RewardsRequest) (*RewardsResponse, error)
+	APR(context.Context, *APRRequest) (*APRResponse, error)
+}
+
+// UnimplementedRewardsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRewardsServiceServer struct {
+}
+
+func (*UnimplementedRewardsServiceServer) Rewards(ctx context.Context, req *RewardsRequest) (*RewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+}
+func (*UnimplementedRewardsServiceServer) APR(ctx context.Context, req *APRRequest) (*APRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method APR not implemented")
+}
+
+func RegisterRewardsServiceServer(s *grpc.Server, srv RewardsServiceServer) {
+	s.RegisterService(&_RewardsService_serviceDesc, srv)
+}
+
+func _RewardsService_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardsServiceServer).Rewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewards_pb.RewardsService/Rewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardsServiceServer).Rewards(ctx, req.(*RewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardsService_APR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardsServiceServer).APR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewards_pb.RewardsService/APR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardsServiceServer).APR(ctx, req.(*APRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RewardsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rewards_pb.RewardsService",
+	HandlerType: (*RewardsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Rewards",
+			Handler:    _RewardsService_Rewards_Handler,
+		},
+		{
+			MethodName: "APR",
+			Handler:    _RewardsService_APR_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rewards.proto",
+}
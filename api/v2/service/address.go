@@ -20,10 +20,11 @@ func (s *Service) Address(_ context.Context, req *pb.AddressRequest) (*pb.Addres
 		return new(pb.AddressResponse), status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.AddressResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
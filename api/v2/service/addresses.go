@@ -11,10 +11,11 @@ import (
 )
 
 func (s *Service) Addresses(_ context.Context, req *pb.AddressesRequest) (*pb.AddressesResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.AddressesResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/MinterTeam/minter-go-node/api/v2/rewards_pb"
+	"github.com/MinterTeam/minter-go-node/core/dao"
+	"github.com/MinterTeam/minter-go-node/core/developers"
+	"github.com/MinterTeam/minter-go-node/core/rewards"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"time"
+)
+
+const (
+	// blockTimeWindow is a number of last blocks used to measure average block time
+	blockTimeWindow = 1000
+
+	defaultBlockTime = 5 * time.Second
+	secondsPerYear   = 365 * 24 * 60 * 60
+)
+
+// APR estimates annual percentage rate of delegators of each candidate. Estimation assumes that current
+// emission, validators set, stakes and commissions stay the same and all validators sign every block.
+func (s *Service) APR(_ context.Context, req *rewards_pb.APRRequest) (*rewards_pb.APRResponse, error) {
+	var filter *types.Pubkey
+	if req.PublicKey != "" {
+		if len(req.PublicKey) < 3 {
+			return nil, status.Error(codes.InvalidArgument, "invalid public_key")
+		}
+		decodeString, err := hex.DecodeString(req.PublicKey[2:])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		pubkey := types.BytesToPubkey(decodeString)
+		filter = &pubkey
+	}
+
+	cState, release, err := s.getStateForHeight(req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	defer release()
+
+	height := uint64(req.Height)
+	if height == 0 {
+		height = s.blockchain.Height()
+	}
+
+	if req.Height != 0 {
+		cState.Lock()
+		cState.Candidates.LoadCandidates()
+		cState.Candidates.LoadStakes()
+		cState.Validators.LoadValidators()
+		cState.Unlock()
+	}
+
+	blockTime := s.averageBlockTime(height)
+	blocksPerYear := uint64(secondsPerYear * time.Second / blockTime)
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	validatorStakes := map[types.Pubkey]*big.Int{}
+	totalPower := big.NewInt(0)
+	for _, val := range cState.Validators.GetValidators() {
+		validatorStakes[val.PubKey] = val.GetTotalBipStake()
+		totalPower.Add(totalPower, val.GetTotalBipStake())
+	}
+
+	rewardPerBlock := rewards.GetRewardForBlock(height)
+
+	result := &rewards_pb.APRResponse{
+		Height:         height,
+		RewardPerBlock: rewardPerBlock.String(),
+		BlockTime:      blockTime.String(),
+		BlocksPerYear:  blocksPerYear,
+		TotalStake:     totalPower.String(),
+		Candidates:     []*rewards_pb.CandidateAPR{},
+	}
+
+	for _, candidate := range cState.Candidates.GetCandidates() {
+		if filter != nil && candidate.PubKey != *filter {
+			continue
+		}
+
+		item := &rewards_pb.CandidateAPR{
+			PublicKey:  candidate.PubKey.String(),
+			Commission: uint32(candidate.Commission),
+			Stake:      cState.Candidates.GetTotalStake(candidate.PubKey).String(),
+			StakeShare: "0",
+			Apr:        "0",
+		}
+
+		if stake, ok := validatorStakes[candidate.PubKey]; ok {
+			item.Validator = true
+			item.Stake = stake.String()
+			item.StakeShare, item.Apr = estimateAPR(rewardPerBlock, blocksPerYear, stake, totalPower, candidate.Commission)
+		}
+
+		result.Candidates = append(result.Candidates, item)
+	}
+
+	if filter != nil && len(result.Candidates) == 0 {
+		return nil, status.Error(codes.NotFound, "Candidate not found")
+	}
+
+	return result, nil
+}
+
+// averageBlockTime measures block time over the last blockTimeWindow blocks before height
+func (s *Service) averageBlockTime(height uint64) time.Duration {
+	if height <= blockTimeWindow {
+		return defaultBlockTime
+	}
+
+	from, to := int64(height-blockTimeWindow), int64(height)
+	fromInfo, err := s.client.BlockchainInfo(from, from)
+	if err != nil || len(fromInfo.BlockMetas) == 0 {
+		return defaultBlockTime
+	}
+
+	toInfo, err := s.client.BlockchainInfo(to, to)
+	if err != nil || len(toInfo.BlockMetas) == 0 {
+		return defaultBlockTime
+	}
+
+	delta := toInfo.BlockMetas[0].Header.Time.Sub(fromInfo.BlockMetas[0].Header.Time)
+	if delta <= 0 {
+		return defaultBlockTime
+	}
+
+	return delta / blockTimeWindow
+}
+
+// estimateAPR returns share of the validator's stake in total stake of validators and APR of its delegators in percents
+func estimateAPR(rewardPerBlock *big.Int, blocksPerYear uint64, stake, totalPower *big.Int, commission uint) (string, string) {
+	if stake.Sign() <= 0 || totalPower.Sign() <= 0 {
+		return "0", "0"
+	}
+
+	share := big.NewFloat(0).Quo(new(big.Float).SetInt(stake), new(big.Float).SetInt(totalPower))
+
+	// yearly reward of the candidate
+	yearReward := new(big.Float).SetInt(rewardPerBlock)
+	yearReward.Mul(yearReward, new(big.Float).SetUint64(blocksPerYear))
+	yearReward.Mul(yearReward, new(big.Float).SetInt(stake))
+	yearReward.Quo(yearReward, new(big.Float).SetInt(totalPower))
+
+	// minus DAO, developers and validator's commissions
+	yearReward.Mul(yearReward, big.NewFloat(float64(100-dao.Commission-developers.Commission)/100))
+	yearReward.Mul(yearReward, big.NewFloat(float64(100-commission)/100))
+
+	return percent(share), percent(yearReward.Quo(yearReward, new(big.Float).SetInt(stake)))
+}
+
+func percent(value *big.Float) string {
+	return new(big.Float).Mul(value, big.NewFloat(100)).Text('f', 4)
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEstimateAPR(t *testing.T) {
+	cases := []struct {
+		name           string
+		rewardPerBlock int64
+		blocksPerYear  uint64
+		stake          int64
+		totalPower     int64
+		commission     uint
+		share          string
+		apr            string
+	}{
+		{name: "half of stake", rewardPerBlock: 100, blocksPerYear: 1000, stake: 50, totalPower: 100, commission: 10, share: "50.0000", apr: "72000.0000"},
+		{name: "whole stake", rewardPerBlock: 100, blocksPerYear: 1000, stake: 100, totalPower: 100, commission: 0, share: "100.0000", apr: "80000.0000"},
+		{name: "full commission", rewardPerBlock: 100, blocksPerYear: 1000, stake: 25, totalPower: 100, commission: 100, share: "25.0000", apr: "0.0000"},
+		{name: "apr does not depend on stake", rewardPerBlock: 100, blocksPerYear: 1000, stake: 1, totalPower: 100, commission: 10, share: "1.0000", apr: "72000.0000"},
+		{name: "zero stake", rewardPerBlock: 100, blocksPerYear: 1000, stake: 0, totalPower: 100, commission: 10, share: "0", apr: "0"},
+		{name: "zero total power", rewardPerBlock: 100, blocksPerYear: 1000, stake: 0, totalPower: 0, commission: 10, share: "0", apr: "0"},
+	}
+
+	for _, c := range cases {
+		share, apr := estimateAPR(big.NewInt(c.rewardPerBlock), c.blocksPerYear, big.NewInt(c.stake), big.NewInt(c.totalPower), c.commission)
+		if share != c.share {
+			t.Errorf("%s: expected stake share %s, got %s", c.name, c.share, share)
+		}
+
+		if apr != c.apr {
+			t.Errorf("%s: expected APR %s, got %s", c.name, c.apr, apr)
+		}
+	}
+}
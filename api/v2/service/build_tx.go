@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/api/v2/tx_pb"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+)
+
+// BuildTx encodes unsigned transaction from its JSON description, see transaction.TxDescription.
+// If nonce is 0 it is taken from the state of the sender at the given height.
+// Hash is a hash which should be signed by the sender.
+func (s *Service) BuildTx(_ context.Context, req *tx_pb.BuildTxRequest) (*tx_pb.BuildTxResponse, error) {
+	if req.Description == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction description is required")
+	}
+
+	description, err := txDescription(req.Description)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if description.Nonce == 0 {
+		sender, err := description.Sender()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("nonce or valid sender address is required: %s", err))
+		}
+
+		cState, release, err := s.getState(req.Height)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		cState.RLock()
+		description.Nonce = cState.Accounts.GetNonce(sender) + 1
+		cState.RUnlock()
+		release()
+	}
+
+	tx, err := description.Build()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &tx_pb.BuildTxResponse{
+		Tx:         fmt.Sprintf("0x%x", encodedTx),
+		Hash:       fmt.Sprintf("0x%x", tx.Hash().Bytes()),
+		Nonce:      fmt.Sprintf("%d", tx.Nonce),
+		Gas:        fmt.Sprintf("%d", tx.Gas()),
+		Commission: tx.CommissionInBaseCoin().String(),
+	}, nil
+}
+
+// txDescription converts description of the request to the one used by transaction builder
+func txDescription(d *tx_pb.TxDescription) (*transaction.TxDescription, error) {
+	if d.ChainId > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid chain_id %d", d.ChainId)
+	}
+	if d.SignatureType > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid signature_type %d", d.SignatureType)
+	}
+
+	var data json.RawMessage
+	if d.Data != nil {
+		encoded, err := (&jsonpb.Marshaler{}).MarshalToString(d.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data: %s", err)
+		}
+		data = json.RawMessage(encoded)
+	}
+
+	return &transaction.TxDescription{
+		Nonce:         d.Nonce,
+		ChainID:       types.ChainID(d.ChainId),
+		GasPrice:      d.GasPrice,
+		GasCoin:       d.GasCoin,
+		Type:          d.Type,
+		Data:          data,
+		Payload:       d.Payload,
+		ServiceData:   d.ServiceData,
+		SignatureType: transaction.SigType(d.SignatureType),
+		Multisig:      d.Multisig,
+		From:          d.From,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"encoding/json"
+	"github.com/MinterTeam/minter-go-node/api/v2/tx_pb"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/golang/protobuf/jsonpb"
+	"strings"
+	"testing"
+)
+
+func TestTxDescription(t *testing.T) {
+	body := `{"nonce":"5","gas_price":2,"type":"send","data":{"coin":"` + types.GetBaseCoin().String() + `","to":"` + types.Address{1}.String() + `","value":"1000000000000000000000"},"payload":"cGF5bG9hZA=="}`
+
+	// the gateway decodes body of build_tx request into the description
+	description := new(tx_pb.TxDescription)
+	if err := jsonpb.Unmarshal(strings.NewReader(body), description); err != nil {
+		t.Fatal(err)
+	}
+
+	converted, err := txDescription(description)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := new(transaction.TxDescription)
+	if err := json.Unmarshal([]byte(strings.Replace(body, `"5"`, `5`, 1)), expected); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := converted.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTx, err := expected.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tx.Hash() != expectedTx.Hash() {
+		t.Fatalf("Transaction built from API description differs from the expected one")
+	}
+}
+
+func TestTxDescriptionWithInvalidChainID(t *testing.T) {
+	if _, err := txDescription(&tx_pb.TxDescription{ChainId: 256, Type: "send"}); err == nil {
+		t.Fatalf("Chain ID above 255 should be rejected")
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/cache"
+	"google.golang.org/grpc"
+	"strings"
+)
+
+// cacheableMethods are methods whose responses to requests with explicit height never change
+var cacheableMethods = map[string]bool{
+	"Address":              true,
+	"Addresses":            true,
+	"Block":                true,
+	"Candidate":            true,
+	"Candidates":           true,
+	"CoinInfo":             true,
+	"CommissionUpdate":     true,
+	"DecodeTx":             true,
+	"DelegationPolicy":     true,
+	"EstimateCoinBuy":      true,
+	"EstimateCoinSell":     true,
+	"EstimateCoinSellAll":  true,
+	"EstimateTxCommission": true,
+	"Events":               true,
+	"MaxGas":               true,
+	"MissedBlocks":         true,
+	"Validators":           true,
+}
+
+// heightChecks are run before cached responses of the methods are served,
+// since their data may be pruned before the state of the height
+var heightChecks = map[string]func(s *Service, height uint64) error{
+	"Events": func(s *Service, height uint64) error { return s.blockchain.CheckEventsHeight(height) },
+}
+
+// CacheInterceptor serves responses to requests pinned to a committed height from the response cache
+// of the blockchain, which is shared with API v1
+func (s *Service) CacheInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	if !cacheableMethods[method] {
+		return handler(ctx, req)
+	}
+
+	height := requestHeight(req)
+	if height == 0 || height >= s.blockchain.Height() {
+		return handler(ctx, req)
+	}
+
+	// handler returns the error itself
+	if check, ok := heightChecks[method]; ok && check(s, height) != nil {
+		return handler(ctx, req)
+	}
+
+	return s.blockchain.ResponseCache().GetOrLoad(cache.Key(info.FullMethod, fmt.Sprintf("%v", req)), height, func() (interface{}, error) {
+		return handler(ctx, req)
+	})
+}
+
+func requestHeight(req interface{}) uint64 {
+	switch r := req.(type) {
+	case interface{ GetHeight() int32 }:
+		if r.GetHeight() > 0 {
+			return uint64(r.GetHeight())
+		}
+	case interface{ GetHeight() int64 }:
+		if r.GetHeight() > 0 {
+			return uint64(r.GetHeight())
+		}
+	case interface{ GetHeight() uint32 }:
+		return uint64(r.GetHeight())
+	case interface{ GetHeight() uint64 }:
+		return r.GetHeight()
+	}
+
+	return 0
+}
@@ -23,10 +23,11 @@ func (s *Service) Candidate(_ context.Context, req *pb.CandidateRequest) (*pb.Ca
 
 	pubkey := types.BytesToPubkey(decodeString)
 
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.CandidateResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	if req.Height != 0 {
 		cState.Lock()
@@ -8,10 +8,11 @@ import (
 )
 
 func (s *Service) Candidates(_ context.Context, req *pb.CandidatesRequest) (*pb.CandidatesResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.CandidatesResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	if req.Height != 0 {
 		cState.Lock()
@@ -10,10 +10,11 @@ import (
 )
 
 func (s *Service) CoinInfo(_ context.Context, req *pb.CoinInfoRequest) (*pb.CoinInfoResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.CoinInfoResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
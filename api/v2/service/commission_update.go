@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/MinterTeam/minter-go-node/api/v2/candidate_pb"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CommissionUpdate returns current commission of the candidate and its scheduled change, if any
+func (s *Service) CommissionUpdate(_ context.Context, req *candidate_pb.CommissionUpdateRequest) (*candidate_pb.CommissionUpdateResponse, error) {
+	if len(req.PublicKey) < 3 {
+		return nil, status.Error(codes.InvalidArgument, "invalid public_key")
+	}
+	decodeString, err := hex.DecodeString(req.PublicKey[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pubkey := types.BytesToPubkey(decodeString)
+
+	cState, release, err := s.getState(req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	defer release()
+
+	if req.Height != 0 {
+		cState.Lock()
+		cState.Candidates.LoadCandidates()
+		cState.Unlock()
+	}
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	candidate := cState.Candidates.GetCandidate(pubkey)
+	if candidate == nil {
+		return nil, status.Error(codes.NotFound, "Candidate not found")
+	}
+
+	result := &candidate_pb.CommissionUpdateResponse{
+		PublicKey:  candidate.PubKey.String(),
+		Commission: uint32(candidate.Commission),
+	}
+
+	if update := candidate.GetCommissionUpdate(); update != nil {
+		result.CommissionUpdate = &candidate_pb.PendingCommission{
+			Commission: uint32(update.Commission),
+			Height:     update.Height,
+		}
+	}
+
+	return result, nil
+}
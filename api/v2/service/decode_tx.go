@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/api/v2/tx_pb"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DecodeTx decodes raw transaction without sending it to the network. Weights of signers of
+// multi-signature transactions are taken from the state at the given height.
+func (s *Service) DecodeTx(_ context.Context, req *tx_pb.DecodeTxRequest) (*tx_pb.DecodeTxResponse, error) {
+	if len(req.Tx) < 3 {
+		return nil, status.Error(codes.InvalidArgument, "invalid tx")
+	}
+
+	decodeString, err := hex.DecodeString(req.Tx[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decodedTx, err := transaction.TxDecoder.DecodeFromBytes(decodeString)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Cannot decode transaction: %s", err))
+	}
+
+	sender, err := decodedTx.Sender()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Cannot recover sender: %s", err))
+	}
+
+	dataStruct, err := s.encodeTxData(decodedTx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &tx_pb.DecodeTxResponse{
+		Hash:          fmt.Sprintf("Mt%x", tmTypes.Tx(decodeString).Hash()),
+		From:          sender.String(),
+		Nonce:         fmt.Sprintf("%d", decodedTx.Nonce),
+		ChainId:       fmt.Sprintf("%d", decodedTx.ChainID),
+		Gas:           fmt.Sprintf("%d", decodedTx.Gas()),
+		GasPrice:      fmt.Sprintf("%d", decodedTx.GasPrice),
+		GasCoin:       decodedTx.GasCoin.String(),
+		Type:          fmt.Sprintf("%d", uint8(decodedTx.Type)),
+		TypeName:      decodedTx.Type.Name(),
+		Data:          dataStruct,
+		Payload:       decodedTx.Payload,
+		ServiceData:   decodedTx.ServiceData,
+		SignatureType: fmt.Sprintf("%d", uint8(decodedTx.SignatureType)),
+	}
+
+	if decodedTx.SignatureType != transaction.SigTypeMulti {
+		return response, nil
+	}
+
+	signers, err := decodedTx.Signers()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Cannot recover signers: %s", err))
+	}
+
+	cState, release, err := s.getState(req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	defer release()
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	// multisig data is empty if the account does not exist, so weights of all signers are zero
+	multisig := cState.Accounts.GetAccount(sender).Multisig()
+
+	var votes uint
+	result := make([]*tx_pb.DecodeTxSigner, 0, len(signers))
+	for _, signer := range signers {
+		weight := multisig.GetWeight(signer)
+		votes += weight
+
+		result = append(result, &tx_pb.DecodeTxSigner{
+			Address: signer.String(),
+			Weight:  fmt.Sprintf("%d", weight),
+		})
+	}
+
+	response.Multisig = &tx_pb.DecodeTxMultisig{
+		Threshold: fmt.Sprintf("%d", multisig.Threshold),
+		Votes:     fmt.Sprintf("%d", votes),
+		Signers:   result,
+	}
+
+	return response, nil
+}
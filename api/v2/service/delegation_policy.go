@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/MinterTeam/minter-go-node/api/v2/candidate_pb"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DelegationPolicy returns restrictions on delegations set by owner of the candidate
+func (s *Service) DelegationPolicy(_ context.Context, req *candidate_pb.DelegationPolicyRequest) (*candidate_pb.DelegationPolicyResponse, error) {
+	if len(req.PublicKey) < 3 {
+		return nil, status.Error(codes.InvalidArgument, "invalid public_key")
+	}
+	decodeString, err := hex.DecodeString(req.PublicKey[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pubkey := types.BytesToPubkey(decodeString)
+
+	cState, release, err := s.getState(req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	defer release()
+
+	if req.Height != 0 {
+		cState.Lock()
+		cState.Candidates.LoadCandidates()
+		cState.Unlock()
+	}
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	candidate := cState.Candidates.GetCandidate(pubkey)
+	if candidate == nil {
+		return nil, status.Error(codes.NotFound, "Candidate not found")
+	}
+
+	result := &candidate_pb.DelegationPolicyResponse{
+		PublicKey:    candidate.PubKey.String(),
+		MinStake:     "0",
+		AllowedCoins: []string{},
+		Whitelist:    []string{},
+	}
+
+	if policy := candidate.GetDelegationPolicy(); policy != nil {
+		result.MinStake = policy.MinStake.String()
+		result.Private = policy.Private
+		for _, coin := range policy.AllowedCoins {
+			result.AllowedCoins = append(result.AllowedCoins, coin.String())
+		}
+		for _, address := range policy.Whitelist {
+			result.Whitelist = append(result.Whitelist, address.String())
+		}
+	}
+
+	return result, nil
+}
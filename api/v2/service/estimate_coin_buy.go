@@ -14,10 +14,11 @@ import (
 )
 
 func (s *Service) EstimateCoinBuy(_ context.Context, req *pb.EstimateCoinBuyRequest) (*pb.EstimateCoinBuyResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.EstimateCoinBuyResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
@@ -14,10 +14,11 @@ import (
 )
 
 func (s *Service) EstimateCoinSell(_ context.Context, req *pb.EstimateCoinSellRequest) (*pb.EstimateCoinSellResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.EstimateCoinSellResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
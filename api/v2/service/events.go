@@ -8,9 +8,15 @@ import (
 	pb "github.com/MinterTeam/node-grpc-gateway/api_pb"
 	"github.com/golang/protobuf/jsonpb"
 	_struct "github.com/golang/protobuf/ptypes/struct"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *Service) Events(_ context.Context, req *pb.EventsRequest) (*pb.EventsResponse, error) {
+	if err := s.blockchain.CheckEventsHeight(uint64(req.Height)); err != nil {
+		return new(pb.EventsResponse), status.Error(codes.NotFound, err.Error())
+	}
+
 	events := s.blockchain.GetEventsDB().LoadEvents(req.Height)
 	resultEvents := make([]*pb.EventsResponse_Event, 0, len(events))
 	for _, event := range events {
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"encoding/hex"
+	"github.com/MinterTeam/minter-go-node/api/v2/export_pb"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamAccounts sends all accounts with balances of the state at the height in order of addresses
+func (s *Service) StreamAccounts(req *export_pb.ExportRequest, stream export_pb.ExportService_StreamAccountsServer) error {
+	return s.export(req, func(cState *state.State, height uint64, cursor []byte, send func(error) bool) error {
+		return cState.Accounts.IterateAccounts(cursor, func(account types.Account, next []byte) bool {
+			item := &export_pb.AccountItem{
+				Address:  account.Address.String(),
+				Nonce:    account.Nonce,
+				Balances: make([]*export_pb.Balance, 0, len(account.Balance)),
+				Cursor:   hex.EncodeToString(next),
+				Height:   height,
+			}
+
+			for _, balance := range account.Balance {
+				item.Balances = append(item.Balances, &export_pb.Balance{
+					Coin:  balance.Coin.String(),
+					Value: balance.Value,
+				})
+			}
+
+			if account.MultisigData != nil {
+				item.Multisig = &export_pb.Multisig{Threshold: uint32(account.MultisigData.Threshold)}
+				for _, weight := range account.MultisigData.Weights {
+					item.Multisig.Weights = append(item.Multisig.Weights, uint32(weight))
+				}
+				for _, address := range account.MultisigData.Addresses {
+					item.Multisig.Addresses = append(item.Multisig.Addresses, address.String())
+				}
+			}
+
+			return send(stream.Send(item))
+		})
+	})
+}
+
+// StreamStakes sends all stakes of the state at the height in order of public keys of candidates
+func (s *Service) StreamStakes(req *export_pb.ExportRequest, stream export_pb.ExportService_StreamStakesServer) error {
+	return s.export(req, func(cState *state.State, height uint64, cursor []byte, send func(error) bool) error {
+		return cState.Candidates.IterateStakes(cursor, func(pubkey types.Pubkey, stake *candidates.Stake, next []byte) bool {
+			return send(stream.Send(&export_pb.StakeItem{
+				PublicKey: pubkey.String(),
+				Owner:     stake.Owner.String(),
+				Coin:      stake.Coin.String(),
+				Value:     stake.Value.String(),
+				BipValue:  stake.BipValue.String(),
+				Cursor:    hex.EncodeToString(next),
+				Height:    height,
+			}))
+		})
+	})
+}
+
+// StreamFrozenFunds sends all frozen funds of the state at the height in order of heights of unfreezing
+func (s *Service) StreamFrozenFunds(req *export_pb.ExportRequest, stream export_pb.ExportService_StreamFrozenFundsServer) error {
+	return s.export(req, func(cState *state.State, height uint64, cursor []byte, send func(error) bool) error {
+		return cState.FrozenFunds.IterateFrozenFunds(cursor, func(fund types.FrozenFund, next []byte) bool {
+			item := &export_pb.FrozenFundItem{
+				UnfreezeHeight: fund.Height,
+				Address:        fund.Address.String(),
+				Coin:           fund.Coin.String(),
+				Value:          fund.Value,
+				Cursor:         hex.EncodeToString(next),
+				Height:         height,
+			}
+			if fund.CandidateKey != nil {
+				item.PublicKey = fund.CandidateKey.String()
+			}
+
+			return send(stream.Send(item))
+		})
+	})
+}
+
+// export iterates the state at the requested height, 0 means the latest one. Iterate should pass result of
+// each Send to send and stop when it returns true, that is on error or when the limit of items is reached.
+// Committed state is immutable, so it is read without locks.
+func (s *Service) export(req *export_pb.ExportRequest, iterate func(cState *state.State, height uint64, cursor []byte, send func(error) bool) error) error {
+	var cursor []byte
+	if req.Cursor != "" {
+		var err error
+		if cursor, err = hex.DecodeString(req.Cursor); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = s.blockchain.Height()
+	}
+
+	cState, release, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	defer release()
+
+	var sendErr error
+	sent := uint64(0)
+	err = iterate(cState, height, cursor, func(err error) bool {
+		if err != nil {
+			sendErr = err
+			return true
+		}
+
+		sent++
+		return req.Limit != 0 && sent >= req.Limit
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return sendErr
+}
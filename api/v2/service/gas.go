@@ -16,10 +16,11 @@ func (s *Service) MinGasPrice(context.Context, *empty.Empty) (*pb.MinGasPriceRes
 }
 
 func (s *Service) MaxGas(_ context.Context, req *pb.MaxGasRequest) (*pb.MaxGasResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.MaxGasResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	return &pb.MaxGasResponse{
 		MaxGas: fmt.Sprintf("%d", cState.App.GetMaxGas()),
@@ -11,10 +11,11 @@ import (
 )
 
 func (s *Service) MissedBlocks(_ context.Context, req *pb.MissedBlocksRequest) (*pb.MissedBlocksResponse, error) {
-	cState, err := s.getStateForHeight(req.Height)
+	cState, release, err := s.getStateForHeight(req.Height)
 	if err != nil {
 		return new(pb.MissedBlocksResponse), status.Error(codes.NotFound, err.Error())
 	}
+	defer release()
 
 	if req.Height != 0 {
 		cState.Lock()
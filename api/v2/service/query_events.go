@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"github.com/MinterTeam/minter-go-node/api/v2/events_pb"
+	"github.com/MinterTeam/minter-go-node/core/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QueryEvents returns reward, slash and unbond events matching the filter over a range of heights
+func (s *Service) QueryEvents(ctx context.Context, req *events_pb.QueryEventsRequest) (*events_pb.QueryEventsResponse, error) {
+	filter, err := events.ParseFilter(req.Type, req.Address, req.Validator, req.Coin, req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	groupBy, err := events.ParseGroupBy(req.GroupBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	q := events.Query{Filter: filter, GroupBy: groupBy}
+	if err := q.SetRange(req.FromHeight, req.ToHeight, s.blockchain.Height()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	q.SetPage(int(req.Page), int(req.PerPage))
+
+	if err := s.blockchain.CheckEventsHeight(q.FromHeight); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	result, err := events.Find(ctx, s.blockchain.GetEventsDB(), q)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	return queryEventsResponse(events.NewResponse(q, result)), nil
+}
+
+func queryEventsResponse(response *events.Response) *events_pb.QueryEventsResponse {
+	result := &events_pb.QueryEventsResponse{
+		FromHeight:  response.FromHeight,
+		ToHeight:    response.ToHeight,
+		Total:       uint64(response.Total),
+		Events:      make([]*events_pb.Event, 0, len(response.Events)),
+		Groups:      make([]*events_pb.Group, 0, len(response.Groups)),
+		TotalGroups: uint64(response.TotalGroups),
+	}
+
+	for _, event := range response.Events {
+		result.Events = append(result.Events, &events_pb.Event{
+			Height:          event.Height,
+			Type:            event.Type,
+			Address:         event.Address,
+			ValidatorPubKey: event.ValidatorPubKey,
+			Coin:            event.Coin,
+			Role:            event.Role,
+			Amount:          event.Amount,
+		})
+	}
+
+	for _, group := range response.Groups {
+		result.Groups = append(result.Groups, &events_pb.Group{
+			Type:            group.Type,
+			Address:         group.Address,
+			ValidatorPubKey: group.ValidatorPubKey,
+			Coin:            group.Coin,
+			Role:            group.Role,
+			Count:           uint64(group.Count),
+			Amount:          group.Amount,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/api/v2/rewards_pb"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"sort"
+	"time"
+)
+
+const (
+	// rewardsPeriod is a number of blocks between PayRewards calls
+	rewardsPeriod = 120
+
+	// maxRewardsRange limits the number of blocks scanned by a single Rewards request
+	maxRewardsRange = 1000000
+)
+
+// Rewards sums up reward events of the address over a range of heights, grouped by candidate and role
+func (s *Service) Rewards(ctx context.Context, req *rewards_pb.RewardsRequest) (*rewards_pb.RewardsResponse, error) {
+	if len(req.Address) < 3 {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	decodeString, err := hex.DecodeString(req.Address[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	address := types.BytesToAddress(decodeString)
+
+	fromHeight, toHeight, err := s.rewardsRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := newRewardsSum(address)
+	for height := (fromHeight + rewardsPeriod - 1) / rewardsPeriod * rewardsPeriod; height <= toHeight; height += rewardsPeriod {
+		if height == 0 {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		if err := s.blockchain.CheckEventsHeight(height); err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if err := sum.add(s.blockchain.GetEventsDB().LoadEvents(uint32(height))); err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("%s at height %d", err, height))
+		}
+	}
+
+	result := sum.response()
+	result.FromHeight = fromHeight
+	result.ToHeight = toHeight
+
+	return result, nil
+}
+
+// rewardsSum accumulates reward events of the address by candidate and role
+type rewardsSum struct {
+	address    types.Address
+	total      *big.Int
+	candidates map[types.Pubkey]*big.Int
+	roles      map[types.Pubkey]map[string]*big.Int
+}
+
+func newRewardsSum(address types.Address) *rewardsSum {
+	return &rewardsSum{
+		address:    address,
+		total:      big.NewInt(0),
+		candidates: map[types.Pubkey]*big.Int{},
+		roles:      map[types.Pubkey]map[string]*big.Int{},
+	}
+}
+
+// add sums up reward events of the address, other events are skipped
+func (r *rewardsSum) add(events eventsdb.Events) error {
+	for _, event := range events {
+		reward, ok := event.(*eventsdb.RewardEvent)
+		if !ok || reward.Address != r.address {
+			continue
+		}
+
+		amount, ok := big.NewInt(0).SetString(reward.Amount, 10)
+		if !ok {
+			return fmt.Errorf("invalid reward amount %q", reward.Amount)
+		}
+
+		if r.candidates[reward.ValidatorPubKey] == nil {
+			r.candidates[reward.ValidatorPubKey] = big.NewInt(0)
+			r.roles[reward.ValidatorPubKey] = map[string]*big.Int{}
+		}
+
+		roles := r.roles[reward.ValidatorPubKey]
+		if roles[reward.Role] == nil {
+			roles[reward.Role] = big.NewInt(0)
+		}
+
+		roles[reward.Role].Add(roles[reward.Role], amount)
+		r.candidates[reward.ValidatorPubKey].Add(r.candidates[reward.ValidatorPubKey], amount)
+		r.total.Add(r.total, amount)
+	}
+
+	return nil
+}
+
+// response returns sums with candidates ordered by public key
+func (r *rewardsSum) response() *rewards_pb.RewardsResponse {
+	result := &rewards_pb.RewardsResponse{
+		Address:    r.address.String(),
+		Total:      r.total.String(),
+		Candidates: make([]*rewards_pb.CandidateReward, 0, len(r.candidates)),
+	}
+
+	for pubkey, candidateTotal := range r.candidates {
+		roles := make(map[string]string, len(r.roles[pubkey]))
+		for role, amount := range r.roles[pubkey] {
+			roles[role] = amount.String()
+		}
+
+		result.Candidates = append(result.Candidates, &rewards_pb.CandidateReward{
+			PublicKey: pubkey.String(),
+			Total:     candidateTotal.String(),
+			Roles:     roles,
+		})
+	}
+
+	sort.Slice(result.Candidates, func(i, j int) bool {
+		return result.Candidates[i].PublicKey < result.Candidates[j].PublicKey
+	})
+
+	return result
+}
+
+// rewardsRange resolves requested heights or times to an inclusive range of heights
+func (s *Service) rewardsRange(req *rewards_pb.RewardsRequest) (uint64, uint64, error) {
+	lastHeight := s.blockchain.Height()
+
+	fromHeight, toHeight := req.FromHeight, req.ToHeight
+
+	if req.FromTime != "" {
+		if fromHeight != 0 {
+			return 0, 0, status.Error(codes.InvalidArgument, "from_height and from_time are mutually exclusive")
+		}
+
+		fromTime, err := time.Parse(time.RFC3339, req.FromTime)
+		if err != nil {
+			return 0, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		fromHeight, err = s.heightByTime(fromTime, lastHeight)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if req.ToTime != "" {
+		if toHeight != 0 {
+			return 0, 0, status.Error(codes.InvalidArgument, "to_height and to_time are mutually exclusive")
+		}
+
+		toTime, err := time.Parse(time.RFC3339, req.ToTime)
+		if err != nil {
+			return 0, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// the last block produced before to_time
+		toHeight, err = s.heightByTime(toTime.Add(time.Nanosecond), lastHeight)
+		if err != nil {
+			return 0, 0, err
+		}
+		if toHeight > 0 {
+			toHeight--
+		}
+	}
+
+	return clampRewardsRange(fromHeight, toHeight, lastHeight)
+}
+
+// clampRewardsRange applies defaults and limits to the range. Zero to means the last height,
+// zero from means the first height of the max range ending at to.
+func clampRewardsRange(fromHeight, toHeight, lastHeight uint64) (uint64, uint64, error) {
+	if toHeight == 0 || toHeight > lastHeight {
+		toHeight = lastHeight
+	}
+
+	if fromHeight == 0 {
+		fromHeight = 1
+		if toHeight > maxRewardsRange {
+			fromHeight = toHeight - maxRewardsRange + 1
+		}
+	}
+
+	if fromHeight > toHeight {
+		return 0, 0, status.Error(codes.InvalidArgument, "from height is greater than to height")
+	}
+
+	if toHeight-fromHeight >= maxRewardsRange {
+		return 0, 0, status.Error(codes.InvalidArgument, fmt.Sprintf("range is too big, max %d blocks allowed", maxRewardsRange))
+	}
+
+	return fromHeight, toHeight, nil
+}
+
+// heightByTime returns the first height whose block time is not before t.
+// If there is no such block, lastHeight+1 is returned.
+func (s *Service) heightByTime(t time.Time, lastHeight uint64) (uint64, error) {
+	var searchErr error
+	n := sort.Search(int(lastHeight), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+
+		height := int64(i + 1)
+		info, err := s.client.BlockchainInfo(height, height)
+		if err != nil || len(info.BlockMetas) == 0 {
+			searchErr = fmt.Errorf("block %d not found", height)
+			return true
+		}
+
+		return !info.BlockMetas[0].Header.Time.Before(t)
+	})
+
+	if searchErr != nil {
+		return 0, status.Error(codes.NotFound, searchErr.Error())
+	}
+
+	return uint64(n + 1), nil
+}
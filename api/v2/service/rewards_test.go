@@ -0,0 +1,131 @@
+package service
+
+import (
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"testing"
+)
+
+func TestClampRewardsRange(t *testing.T) {
+	cases := []struct {
+		name       string
+		from, to   uint64
+		last       uint64
+		resultFrom uint64
+		resultTo   uint64
+		err        bool
+	}{
+		{name: "defaults", last: 100, resultFrom: 1, resultTo: 100},
+		{name: "default from on long chain", last: maxRewardsRange + 10, resultFrom: 11, resultTo: maxRewardsRange + 10},
+		{name: "default from with to", to: maxRewardsRange + 5, last: maxRewardsRange + 10, resultFrom: 6, resultTo: maxRewardsRange + 5},
+		{name: "to above last height", from: 10, to: 200, last: 100, resultFrom: 10, resultTo: 100},
+		{name: "single block", from: 50, to: 50, last: 100, resultFrom: 50, resultTo: 50},
+		{name: "max range", from: 1, to: maxRewardsRange, last: maxRewardsRange, resultFrom: 1, resultTo: maxRewardsRange},
+		{name: "range too big", from: 1, to: maxRewardsRange + 1, last: maxRewardsRange + 1, err: true},
+		{name: "from above to", from: 60, to: 50, last: 100, err: true},
+		{name: "from above last height", from: 200, last: 100, err: true},
+	}
+
+	for _, c := range cases {
+		from, to, err := clampRewardsRange(c.from, c.to, c.last)
+		if c.err {
+			if err == nil {
+				t.Errorf("%s: expected error, got range %d-%d", c.name, from, to)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+
+		if from != c.resultFrom || to != c.resultTo {
+			t.Errorf("%s: expected range %d-%d, got %d-%d", c.name, c.resultFrom, c.resultTo, from, to)
+		}
+	}
+}
+
+func TestRewardsSum(t *testing.T) {
+	address := types.Address{1}
+	other := types.Address{2}
+	first := types.Pubkey{1}
+	second := types.Pubkey{2}
+
+	sum := newRewardsSum(address)
+
+	blocks := []eventsdb.Events{
+		{
+			&eventsdb.RewardEvent{Role: "Validator", Address: address, Amount: "100", ValidatorPubKey: second},
+			&eventsdb.RewardEvent{Role: "Delegator", Address: address, Amount: "50", ValidatorPubKey: second},
+			&eventsdb.RewardEvent{Role: "Delegator", Address: other, Amount: "1000", ValidatorPubKey: second},
+			&eventsdb.SlashEvent{Address: address, Amount: "10", Coin: types.GetBaseCoin(), ValidatorPubKey: second},
+		},
+		{
+			&eventsdb.RewardEvent{Role: "Delegator", Address: address, Amount: "25", ValidatorPubKey: first},
+			&eventsdb.RewardEvent{Role: "Delegator", Address: address, Amount: "5", ValidatorPubKey: second},
+		},
+		{},
+	}
+
+	for _, events := range blocks {
+		if err := sum.add(events); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	response := sum.response()
+	if response.Address != address.String() {
+		t.Errorf("Expected address %s, got %s", address.String(), response.Address)
+	}
+
+	if response.Total != "180" {
+		t.Errorf("Expected total 180, got %s", response.Total)
+	}
+
+	if len(response.Candidates) != 2 {
+		t.Fatalf("Expected 2 candidates, got %d", len(response.Candidates))
+	}
+
+	cases := []struct {
+		pubkey types.Pubkey
+		total  string
+		roles  map[string]string
+	}{
+		{pubkey: first, total: "25", roles: map[string]string{"Delegator": "25"}},
+		{pubkey: second, total: "155", roles: map[string]string{"Validator": "100", "Delegator": "55"}},
+	}
+
+	for i, c := range cases {
+		candidate := response.Candidates[i]
+		if candidate.PublicKey != c.pubkey.String() {
+			t.Errorf("Expected candidate %s at %d, got %s", c.pubkey.String(), i, candidate.PublicKey)
+		}
+
+		if candidate.Total != c.total {
+			t.Errorf("Expected total %s of %s, got %s", c.total, candidate.PublicKey, candidate.Total)
+		}
+
+		if len(candidate.Roles) != len(c.roles) {
+			t.Errorf("Expected %d roles of %s, got %d", len(c.roles), candidate.PublicKey, len(candidate.Roles))
+		}
+
+		for role, amount := range c.roles {
+			if candidate.Roles[role] != amount {
+				t.Errorf("Expected %s reward %s of %s, got %s", role, amount, candidate.PublicKey, candidate.Roles[role])
+			}
+		}
+	}
+}
+
+func TestRewardsSumInvalidAmount(t *testing.T) {
+	address := types.Address{1}
+
+	sum := newRewardsSum(address)
+	err := sum.add(eventsdb.Events{
+		&eventsdb.RewardEvent{Role: "Validator", Address: address, Amount: "invalid", ValidatorPubKey: types.Pubkey{1}},
+	})
+	if err == nil {
+		t.Fatal("Expected error on invalid amount")
+	}
+}
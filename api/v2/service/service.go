@@ -26,16 +26,23 @@ func NewService(cdc *amino.Codec, blockchain *minter.Blockchain, client *rpc.Loc
 	return &Service{cdc: cdc, blockchain: blockchain, client: client, minterCfg: minterCfg, version: version, tmNode: node}
 }
 
-func (s *Service) getStateForHeight(height int32) (*state.State, error) {
+// getStateForHeight returns state at height or the current one if height is 0.
+// Release should be called when the state is not used anymore.
+func (s *Service) getStateForHeight(height int32) (*state.State, func(), error) {
 	if height > 0 {
-		cState, err := s.blockchain.GetStateForHeight(uint64(height))
-		if err != nil {
-			return nil, err
-		}
-		return cState, nil
+		return s.getState(uint64(height))
 	}
 
-	return s.blockchain.CurrentState(), nil
+	return s.getState(0)
+}
+
+// getState returns the state of the height, 0 means the current one
+func (s *Service) getState(height uint64) (*state.State, func(), error) {
+	if height > 0 {
+		return s.blockchain.GetStateForHeight(height)
+	}
+
+	return s.blockchain.CurrentState(), func() {}, nil
 }
 
 func (s *Service) createError(statusErr *status.Status, data string) error {
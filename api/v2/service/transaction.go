@@ -96,6 +96,12 @@ func (s *Service) encodeTxData(decodedTx *transaction.Transaction) (*_struct.Str
 		b, err = s.cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.CreateMultisigData))
 	case transaction.TypeEditCandidate:
 		b, err = s.cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.EditCandidateData))
+	case transaction.TypeEditCandidateCommission:
+		b, err = s.cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.EditCandidateCommissionData))
+	case transaction.TypeEditCandidatePublicKey:
+		b, err = s.cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.EditCandidatePublicKeyData))
+	case transaction.TypeSetDelegationPolicy:
+		b, err = s.cdc.MarshalJSON(decodedTx.GetDecodedData().(*transaction.SetDelegationPolicyData))
 	default:
 		return nil, errors.New("unknown tx type")
 	}
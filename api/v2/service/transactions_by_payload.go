@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/api/v2/payloads_pb"
+	"github.com/MinterTeam/minter-go-node/core/payloads"
+	pb "github.com/MinterTeam/node-grpc-gateway/api_pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPayloadSearchPerPage limits the number of transactions returned by a single search
+const maxPayloadSearchPerPage = 100
+
+// TransactionsByPayload returns successful transactions whose payload or service data equals the value
+// or starts with it if prefix is set
+func (s *Service) TransactionsByPayload(ctx context.Context, req *payloads_pb.TransactionsByPayloadRequest) (*pb.TransactionsResponse, error) {
+	field, err := payloads.ParseField(req.Field)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	perPage := int(req.PerPage)
+	if perPage <= 0 || perPage > maxPayloadSearchPerPage {
+		perPage = maxPayloadSearchPerPage
+	}
+
+	entries, err := s.blockchain.PayloadIndex().Find(field, []byte(req.Value), req.Prefix, (page-1)*perPage, perPage)
+	if err == payloads.ErrDisabled {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result := make([]*pb.TransactionResponse, 0, len(entries))
+	for _, entry := range entries {
+		tx, err := s.Transaction(ctx, &pb.TransactionRequest{Hash: fmt.Sprintf("Mt%x", entry.Hash)})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tx)
+	}
+
+	return &pb.TransactionsResponse{
+		Transactions: result,
+	}, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	compact_db "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/api/v2/updates_pb"
+	"github.com/MinterTeam/minter-go-node/core/subscriptions"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+)
+
+// updatesFilter is a set of addresses, candidates and coins a client is subscribed to
+type updatesFilter struct {
+	addresses  map[types.Address]bool
+	candidates map[types.Pubkey]bool
+	coins      map[types.CoinSymbol]bool
+}
+
+func (s *Service) SubscribeUpdates(req *updates_pb.SubscribeUpdatesRequest, stream updates_pb.UpdatesService_SubscribeUpdatesServer) error {
+	return s.Updates(stream.Context(), req, stream.Send)
+}
+
+// Updates sends to the client changes of balances, candidates and coins made by each block
+// until the context is done. Blocks which change nothing the client is subscribed to are skipped.
+func (s *Service) Updates(ctx context.Context, req *updates_pb.SubscribeUpdatesRequest, send func(*updates_pb.UpdatesResponse) error) error {
+	filter, err := newUpdatesFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub, history, err := s.blockchain.Updates().Subscribe(req.FromHeight)
+	if err == subscriptions.ErrTooManySubscribers {
+		return status.Error(codes.ResourceExhausted, fmt.Sprintf("max_subscription_clients %d reached", s.minterCfg.RPC.MaxSubscriptionClients))
+	}
+	if err == subscriptions.ErrHeightPruned {
+		return status.Error(codes.OutOfRange, fmt.Sprintf("updates from height %d are not available", req.FromHeight))
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer sub.Close()
+
+	for _, update := range history {
+		if err := sendUpdate(update, filter, send); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Aborted, "subscriber is too slow, resubscribe with from_height")
+			}
+			if err := sendUpdate(update, filter, send); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendUpdate(update *subscriptions.Update, filter *updatesFilter, send func(*updates_pb.UpdatesResponse) error) error {
+	response := filter.apply(update)
+	if len(response.Balances) == 0 && len(response.Candidates) == 0 && len(response.Coins) == 0 {
+		return nil
+	}
+
+	return send(response)
+}
+
+func newUpdatesFilter(req *updates_pb.SubscribeUpdatesRequest) (*updatesFilter, error) {
+	if len(req.Addresses) == 0 && len(req.PublicKeys) == 0 && len(req.Coins) == 0 {
+		return nil, fmt.Errorf("no addresses, public keys or coins to subscribe to")
+	}
+
+	filter := &updatesFilter{
+		addresses:  map[types.Address]bool{},
+		candidates: map[types.Pubkey]bool{},
+		coins:      map[types.CoinSymbol]bool{},
+	}
+
+	for _, address := range req.Addresses {
+		if len(address) < 3 {
+			return nil, fmt.Errorf("invalid address %q", address)
+		}
+
+		decoded, err := hex.DecodeString(address[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %s", address, err)
+		}
+
+		filter.addresses[types.BytesToAddress(decoded)] = true
+	}
+
+	for _, publicKey := range req.PublicKeys {
+		if len(publicKey) < 3 {
+			return nil, fmt.Errorf("invalid public key %q", publicKey)
+		}
+
+		decoded, err := hex.DecodeString(publicKey[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %s", publicKey, err)
+		}
+
+		filter.candidates[types.BytesToPubkey(decoded)] = true
+	}
+
+	for _, coin := range req.Coins {
+		filter.coins[types.StrToCoinSymbol(coin)] = true
+	}
+
+	return filter, nil
+}
+
+// apply returns part of the update the client is subscribed to
+func (f *updatesFilter) apply(update *subscriptions.Update) *updates_pb.UpdatesResponse {
+	response := &updates_pb.UpdatesResponse{Height: update.Height}
+
+	for address := range f.addresses {
+		for coin, value := range update.Balances[address] {
+			response.Balances = append(response.Balances, &updates_pb.BalanceUpdate{
+				Address: address.String(),
+				Coin:    coin.String(),
+				Value:   value.String(),
+			})
+		}
+	}
+
+	candidates := map[types.Pubkey]*updates_pb.CandidateUpdate{}
+	candidate := func(pubkey types.Pubkey) *updates_pb.CandidateUpdate {
+		if _, ok := candidates[pubkey]; !ok {
+			candidates[pubkey] = &updates_pb.CandidateUpdate{PublicKey: pubkey.String()}
+			response.Candidates = append(response.Candidates, candidates[pubkey])
+		}
+
+		return candidates[pubkey]
+	}
+
+	for pubkey := range f.candidates {
+		if totalStake, ok := update.TotalStakes[pubkey]; ok {
+			candidate(pubkey).TotalStake = totalStake.String()
+		}
+	}
+
+	for _, event := range update.Events {
+		switch e := event.(type) {
+		case *compact_db.RewardEvent:
+			if f.candidates[e.ValidatorPubKey] {
+				c := candidate(e.ValidatorPubKey)
+				c.Events = append(c.Events, &updates_pb.CandidateEvent{
+					Type:    "minter/RewardEvent",
+					Address: e.Address.String(),
+					Amount:  e.Amount,
+					Role:    e.Role,
+				})
+			}
+		case *compact_db.SlashEvent:
+			if f.candidates[e.ValidatorPubKey] {
+				c := candidate(e.ValidatorPubKey)
+				c.Events = append(c.Events, &updates_pb.CandidateEvent{
+					Type:    "minter/SlashEvent",
+					Address: e.Address.String(),
+					Amount:  e.Amount,
+					Coin:    e.Coin.String(),
+				})
+			}
+		case *compact_db.UnbondEvent:
+			if f.candidates[e.ValidatorPubKey] {
+				c := candidate(e.ValidatorPubKey)
+				c.Events = append(c.Events, &updates_pb.CandidateEvent{
+					Type:    "minter/UnbondEvent",
+					Address: e.Address.String(),
+					Amount:  e.Amount,
+					Coin:    e.Coin.String(),
+				})
+			}
+		}
+	}
+
+	for symbol := range f.coins {
+		coin, ok := update.Coins[symbol]
+		if !ok {
+			continue
+		}
+
+		response.Coins = append(response.Coins, &updates_pb.CoinUpdate{
+			Symbol:         symbol.String(),
+			Volume:         coin.Volume.String(),
+			ReserveBalance: coin.Reserve.String(),
+			Crr:            uint32(coin.Crr),
+			Price:          coin.Price().String(),
+		})
+	}
+
+	// filter and update are maps, so the order is fixed to make responses reproducible
+	sort.Slice(response.Balances, func(i, j int) bool {
+		a, b := response.Balances[i], response.Balances[j]
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.Coin < b.Coin
+	})
+	sort.Slice(response.Candidates, func(i, j int) bool {
+		return response.Candidates[i].PublicKey < response.Candidates[j].PublicKey
+	})
+	sort.Slice(response.Coins, func(i, j int) bool {
+		return response.Coins[i].Symbol < response.Coins[j].Symbol
+	})
+
+	return response
+}
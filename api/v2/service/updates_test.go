@@ -0,0 +1,64 @@
+package service
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/subscriptions"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"math/big"
+	"testing"
+)
+
+func TestUpdatesFilterOrder(t *testing.T) {
+	filter := &updatesFilter{
+		addresses:  map[types.Address]bool{},
+		candidates: map[types.Pubkey]bool{},
+		coins:      map[types.CoinSymbol]bool{},
+	}
+
+	update := &subscriptions.Update{
+		Height:      1,
+		Balances:    map[types.Address]map[types.CoinSymbol]*big.Int{},
+		Coins:       map[types.CoinSymbol]*subscriptions.Coin{},
+		TotalStakes: map[types.Pubkey]*big.Int{},
+	}
+
+	coins := []types.CoinSymbol{types.StrToCoinSymbol("AAA"), types.StrToCoinSymbol("BBB"), types.StrToCoinSymbol("CCC")}
+	for i := byte(1); i <= 10; i++ {
+		address, pubkey := types.Address{i}, types.Pubkey{i}
+		filter.addresses[address] = true
+		filter.candidates[pubkey] = true
+
+		update.Balances[address] = map[types.CoinSymbol]*big.Int{}
+		for _, coin := range coins {
+			update.Balances[address][coin] = big.NewInt(int64(i))
+		}
+		update.TotalStakes[pubkey] = big.NewInt(int64(i))
+	}
+	for _, coin := range coins {
+		filter.coins[coin] = true
+		update.Coins[coin] = &subscriptions.Coin{Volume: big.NewInt(1), Reserve: big.NewInt(1), Crr: 100}
+	}
+
+	response := filter.apply(update)
+	if len(response.Balances) != 30 || len(response.Candidates) != 10 || len(response.Coins) != 3 {
+		t.Fatalf("Wrong number of updates in the response")
+	}
+
+	for i := 1; i < len(response.Balances); i++ {
+		a, b := response.Balances[i-1], response.Balances[i]
+		if a.Address > b.Address || (a.Address == b.Address && a.Coin >= b.Coin) {
+			t.Fatalf("Balances are not sorted by address and coin")
+		}
+	}
+
+	for i := 1; i < len(response.Candidates); i++ {
+		if response.Candidates[i-1].PublicKey >= response.Candidates[i].PublicKey {
+			t.Fatalf("Candidates are not sorted by public key")
+		}
+	}
+
+	for i := 1; i < len(response.Coins); i++ {
+		if response.Coins[i-1].Symbol >= response.Coins[i].Symbol {
+			t.Fatalf("Coins are not sorted by symbol")
+		}
+	}
+}
@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tx.proto
+
+package tx_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DecodeTxRequest struct {
+	// hex encoded transaction prefixed with Mt
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// height of the state to take weights of multisig signers from, 0 means the latest one
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodeTxRequest) Reset()         { *m = DecodeTxRequest{} }
+func (m *DecodeTxRequest) String() string { return proto.CompactTextString(m) }
+func (*DecodeTxRequest) ProtoMessage()    {}
+func (*DecodeTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{0}
+}
+
+func (m *DecodeTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTxRequest.Unmarshal(m, b)
+}
+func (m *DecodeTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTxRequest.Marshal(b, m, deterministic)
+}
+func (m *DecodeTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTxRequest.Merge(m, src)
+}
+func (m *DecodeTxRequest) XXX_Size() int {
+	return xxx_messageInfo_DecodeTxRequest.Size(m)
+}
+func (m *DecodeTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeTxRequest proto.InternalMessageInfo
+
+func (m *DecodeTxRequest) GetTx() string {
+	if m != nil {
+		return m.Tx
+	}
+	return ""
+}
+
+func (m *DecodeTxRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type DecodeTxResponse struct {
+	Hash          string          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From          string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Nonce         string          `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ChainId       string          `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Gas           string          `protobuf:"bytes,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice      string          `protobuf:"bytes,6,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasCoin       string          `protobuf:"bytes,7,opt,name=gas_coin,json=gasCoin,proto3" json:"gas_coin,omitempty"`
+	Type          string          `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	TypeName      string          `protobuf:"bytes,9,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Data          *_struct.Struct `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	Payload       []byte          `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`
+	ServiceData   []byte          `protobuf:"bytes,12,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
+	SignatureType string          `protobuf:"bytes,13,opt,name=signature_type,json=signatureType,proto3" json:"signature_type,omitempty"`
+	// empty for single signature transactions
+	Multisig             *DecodeTxMultisig `protobuf:"bytes,14,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DecodeTxResponse) Reset()         { *m = DecodeTxResponse{} }
+func (m *DecodeTxResponse) String() string { return proto.CompactTextString(m) }
+func (*DecodeTxResponse) ProtoMessage()    {}
+func (*DecodeTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{1}
+}
+
+func (m *DecodeTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTxResponse.Unmarshal(m, b)
+}
+func (m *DecodeTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTxResponse.Marshal(b, m, deterministic)
+}
+func (m *DecodeTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTxResponse.Merge(m, src)
+}
+func (m *DecodeTxResponse) XXX_Size() int {
+	return xxx_messageInfo_DecodeTxResponse.Size(m)
+}
+func (m *DecodeTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeTxResponse proto.InternalMessageInfo
+
+func (m *DecodeTxResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetGasCoin() string {
+	if m != nil {
+		return m.GasCoin
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DecodeTxResponse) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *DecodeTxResponse) GetServiceData() []byte {
+	if m != nil {
+		return m.ServiceData
+	}
+	return nil
+}
+
+func (m *DecodeTxResponse) GetSignatureType() string {
+	if m != nil {
+		return m.SignatureType
+	}
+	return ""
+}
+
+func (m *DecodeTxResponse) GetMultisig() *DecodeTxMultisig {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
+type DecodeTxMultisig struct {
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// sum of weights of the signers
+	Votes                string            `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	Signers              []*DecodeTxSigner `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DecodeTxMultisig) Reset()         { *m = DecodeTxMultisig{} }
+func (m *DecodeTxMultisig) String() string { return proto.CompactTextString(m) }
+func (*DecodeTxMultisig) ProtoMessage()    {}
+func (*DecodeTxMultisig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{2}
+}
+
+func (m *DecodeTxMultisig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTxMultisig.Unmarshal(m, b)
+}
+func (m *DecodeTxMultisig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTxMultisig.Marshal(b, m, deterministic)
+}
+func (m *DecodeTxMultisig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTxMultisig.Merge(m, src)
+}
+func (m *DecodeTxMultisig) XXX_Size() int {
+	return xxx_messageInfo_DecodeTxMultisig.Size(m)
+}
+func (m *DecodeTxMultisig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTxMultisig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeTxMultisig proto.InternalMessageInfo
+
+func (m *DecodeTxMultisig) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *DecodeTxMultisig) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+func (m *DecodeTxMultisig) GetSigners() []*DecodeTxSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+type DecodeTxSigner struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight               string   `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodeTxSigner) Reset()         { *m = DecodeTxSigner{} }
+func (m *DecodeTxSigner) String() string { return proto.CompactTextString(m) }
+func (*DecodeTxSigner) ProtoMessage()    {}
+func (*DecodeTxSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{3}
+}
+
+func (m *DecodeTxSigner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTxSigner.Unmarshal(m, b)
+}
+func (m *DecodeTxSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTxSigner.Marshal(b, m, deterministic)
+}
+func (m *DecodeTxSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTxSigner.Merge(m, src)
+}
+func (m *DecodeTxSigner) XXX_Size() int {
+	return xxx_messageInfo_DecodeTxSigner.Size(m)
+}
+func (m *DecodeTxSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTxSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeTxSigner proto.InternalMessageInfo
+
+func (m *DecodeTxSigner) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DecodeTxSigner) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+type BuildTxRequest struct {
+	Description *TxDescription `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// height of the state to take nonce of the sender from, 0 means the latest one
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildTxRequest) Reset()         { *m = BuildTxRequest{} }
+func (m *BuildTxRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildTxRequest) ProtoMessage()    {}
+func (*BuildTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{4}
+}
+
+func (m *BuildTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildTxRequest.Unmarshal(m, b)
+}
+func (m *BuildTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildTxRequest.Marshal(b, m, deterministic)
+}
+func (m *BuildTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildTxRequest.Merge(m, src)
+}
+func (m *BuildTxRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildTxRequest.Size(m)
+}
+func (m *BuildTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildTxRequest proto.InternalMessageInfo
+
+func (m *BuildTxRequest) GetDescription() *TxDescription {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *BuildTxRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// TxDescription is a JSON description of unsigned transaction, empty chain_id, gas_price, gas_coin and
+// signature_type are set to current chain, 1, base coin and single signature.
+type TxDescription struct {
+	// 0 means the next nonce of the sender
+	Nonce    uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ChainId  uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GasPrice uint32 `protobuf:"varint,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasCoin  string `protobuf:"bytes,4,opt,name=gas_coin,json=gasCoin,proto3" json:"gas_coin,omitempty"`
+	// name of the transaction type, e.g. send
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// fields of the transaction data, amounts are strings in pips
+	Data          *_struct.Struct `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Payload       []byte          `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	ServiceData   []byte          `protobuf:"bytes,8,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
+	SignatureType uint32          `protobuf:"varint,9,opt,name=signature_type,json=signatureType,proto3" json:"signature_type,omitempty"`
+	// address of multisig account, required for multi-signature transactions
+	Multisig string `protobuf:"bytes,10,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	// sender whose nonce is taken from the state if nonce is 0, multisig is used if it is empty
+	From                 string   `protobuf:"bytes,11,opt,name=from,proto3" json:"from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxDescription) Reset()         { *m = TxDescription{} }
+func (m *TxDescription) String() string { return proto.CompactTextString(m) }
+func (*TxDescription) ProtoMessage()    {}
+func (*TxDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{5}
+}
+
+func (m *TxDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxDescription.Unmarshal(m, b)
+}
+func (m *TxDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxDescription.Marshal(b, m, deterministic)
+}
+func (m *TxDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxDescription.Merge(m, src)
+}
+func (m *TxDescription) XXX_Size() int {
+	return xxx_messageInfo_TxDescription.Size(m)
+}
+func (m *TxDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxDescription proto.InternalMessageInfo
+
+func (m *TxDescription) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TxDescription) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TxDescription) GetGasPrice() uint32 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *TxDescription) GetGasCoin() string {
+	if m != nil {
+		return m.GasCoin
+	}
+	return ""
+}
+
+func (m *TxDescription) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TxDescription) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TxDescription) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *TxDescription) GetServiceData() []byte {
+	if m != nil {
+		return m.ServiceData
+	}
+	return nil
+}
+
+func (m *TxDescription) GetSignatureType() uint32 {
+	if m != nil {
+		return m.SignatureType
+	}
+	return 0
+}
+
+func (m *TxDescription) GetMultisig() string {
+	if m != nil {
+		return m.Multisig
+	}
+	return ""
+}
+
+func (m *TxDescription) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+type BuildTxResponse struct {
+	// hex encoded unsigned transaction
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// hash which should be signed by the sender
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Nonce                string   `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Gas                  string   `protobuf:"bytes,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	Commission           string   `protobuf:"bytes,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildTxResponse) Reset()         { *m = BuildTxResponse{} }
+func (m *BuildTxResponse) String() string { return proto.CompactTextString(m) }
+func (*BuildTxResponse) ProtoMessage()    {}
+func (*BuildTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{6}
+}
+
+func (m *BuildTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildTxResponse.Unmarshal(m, b)
+}
+func (m *BuildTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildTxResponse.Marshal(b, m, deterministic)
+}
+func (m *BuildTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildTxResponse.Merge(m, src)
+}
+func (m *BuildTxResponse) XXX_Size() int {
+	return xxx_messageInfo_BuildTxResponse.Size(m)
+}
+func (m *BuildTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildTxResponse proto.InternalMessageInfo
+
+func (m *BuildTxResponse) GetTx() string {
+	if m != nil {
+		return m.Tx
+	}
+	return ""
+}
+
+func (m *BuildTxResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BuildTxResponse) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *BuildTxResponse) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+func (m *BuildTxResponse) GetCommission() string {
+	if m != nil {
+		return m.Commission
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DecodeTxRequest)(nil), "tx_pb.DecodeTxRequest")
+	proto.RegisterType((*DecodeTxResponse)(nil), "tx_pb.DecodeTxResponse")
+	proto.RegisterType((*DecodeTxMultisig)(nil), "tx_pb.DecodeTxMultisig")
+	proto.RegisterType((*DecodeTxSigner)(nil), "tx_pb.DecodeTxSigner")
+	proto.RegisterType((*BuildTxRequest)(nil), "tx_pb.BuildTxRequest")
+	proto.RegisterType((*TxDescription)(nil), "tx_pb.TxDescription")
+	proto.RegisterType((*BuildTxResponse)(nil), "tx_pb.BuildTxResponse")
+}
+
+func init() {
+	proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c)
+}
+
+var fileDescriptor_0fd2153dc07d3b5c = []byte{
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6e, 0xdb, 0x3a,
+	0x10, 0xc7, 0x21, 0x7f, 0x4a, 0x23, 0xdb, 0x09, 0x88, 0xbc, 0x84, 0xcf, 0x2f, 0x08, 0xfc, 0x04,
+	0x14, 0x30, 0x50, 0xc0, 0x06, 0x1c, 0xa0, 0x40, 0xbb, 0x4c, 0xb3, 0xe9, 0xa2, 0x1f, 0x50, 0xbc,
+	0xe8, 0x4e, 0xa5, 0x25, 0x46, 0x22, 0x60, 0x8b, 0xaa, 0x48, 0x27, 0xca, 0xa2, 0x9b, 0x5e, 0xa1,
+	0xd7, 0xe8, 0xba, 0xb7, 0xe8, 0xaa, 0x57, 0xe8, 0x41, 0x0a, 0x52, 0x94, 0x65, 0xbb, 0x6e, 0xd1,
+	0xae, 0xcc, 0xf9, 0xff, 0x87, 0xe3, 0x11, 0xe7, 0x47, 0x82, 0x2d, 0x8b, 0x49, 0x96, 0x73, 0xc9,
+	0x51, 0x5b, 0x16, 0x41, 0xb6, 0x18, 0x9e, 0xc7, 0x9c, 0xc7, 0x4b, 0x3a, 0x25, 0x19, 0x9b, 0x92,
+	0x34, 0xe5, 0x92, 0x48, 0xc6, 0x53, 0x51, 0x26, 0x6d, 0x5c, 0x1d, 0x2d, 0xd6, 0xb7, 0x53, 0x21,
+	0xf3, 0x75, 0x28, 0x4b, 0xd7, 0x7b, 0x0a, 0x47, 0xd7, 0x34, 0xe4, 0x11, 0x9d, 0x17, 0x3e, 0x7d,
+	0xbf, 0xa6, 0x42, 0xa2, 0x01, 0x34, 0x64, 0x81, 0xad, 0x91, 0x35, 0x76, 0xfc, 0x86, 0x2c, 0xd0,
+	0x29, 0x74, 0x12, 0xca, 0xe2, 0x44, 0xe2, 0xc6, 0xc8, 0x1a, 0xb7, 0x7c, 0x13, 0x79, 0x9f, 0x9b,
+	0x70, 0x5c, 0xef, 0x15, 0x19, 0x4f, 0x05, 0x45, 0x08, 0x5a, 0x09, 0x11, 0x89, 0xd9, 0xae, 0xd7,
+	0x4a, 0xbb, 0xcd, 0xf9, 0x4a, 0x6f, 0x77, 0x7c, 0xbd, 0x46, 0x27, 0xd0, 0x4e, 0x79, 0x1a, 0x52,
+	0xdc, 0xd4, 0x62, 0x19, 0xa0, 0x7f, 0xc1, 0x0e, 0x13, 0xc2, 0xd2, 0x80, 0x45, 0xb8, 0xa5, 0x8d,
+	0xae, 0x8e, 0x5f, 0x44, 0xe8, 0x18, 0x9a, 0x31, 0x11, 0xb8, 0xad, 0x55, 0xb5, 0x44, 0xff, 0x81,
+	0x13, 0x13, 0x11, 0x64, 0x39, 0x0b, 0x29, 0xee, 0x68, 0xdd, 0x8e, 0x89, 0x78, 0x93, 0xb3, 0xb2,
+	0x92, 0x32, 0x43, 0xce, 0x52, 0xdc, 0x2d, 0x2b, 0xc5, 0x44, 0x3c, 0xe7, 0x2c, 0x55, 0xed, 0xc8,
+	0x87, 0x8c, 0x62, 0xbb, 0x6c, 0x47, 0xad, 0x55, 0x2d, 0xf5, 0x1b, 0xa4, 0x64, 0x45, 0xb1, 0x53,
+	0xd6, 0x52, 0xc2, 0x2b, 0xb2, 0xa2, 0xe8, 0x31, 0xb4, 0x22, 0x22, 0x09, 0x86, 0x91, 0x35, 0x76,
+	0x67, 0x67, 0x93, 0xf2, 0x40, 0x27, 0xd5, 0x81, 0x4e, 0x6e, 0xf4, 0x81, 0xfa, 0x3a, 0x09, 0x61,
+	0xe8, 0x66, 0xe4, 0x61, 0xc9, 0x49, 0x84, 0xdd, 0x91, 0x35, 0xee, 0xf9, 0x55, 0x88, 0xfe, 0x87,
+	0x9e, 0xa0, 0xf9, 0x1d, 0x0b, 0x69, 0xa0, 0xcb, 0xf5, 0xb4, 0xed, 0x1a, 0xed, 0x5a, 0x6d, 0x7e,
+	0x04, 0x03, 0xc1, 0xe2, 0x94, 0xc8, 0x75, 0x4e, 0x03, 0xdd, 0x64, 0x5f, 0xf7, 0xd2, 0xdf, 0xa8,
+	0x73, 0xd5, 0xed, 0x25, 0xd8, 0xab, 0xf5, 0x52, 0x32, 0xc1, 0x62, 0x3c, 0x30, 0x4d, 0x69, 0x14,
+	0x26, 0xd5, 0x3c, 0x5e, 0x1a, 0xdb, 0xdf, 0x24, 0x7a, 0xf7, 0x70, 0xbc, 0xef, 0xa2, 0x73, 0x70,
+	0x64, 0x92, 0x53, 0x91, 0xf0, 0x65, 0x64, 0x46, 0x56, 0x0b, 0x6a, 0x46, 0x77, 0x5c, 0x52, 0x61,
+	0x06, 0x57, 0x06, 0x68, 0x0a, 0x5d, 0xd5, 0x0d, 0xcd, 0x05, 0x6e, 0x8e, 0x9a, 0x63, 0x77, 0xf6,
+	0xcf, 0xde, 0x7f, 0xdf, 0x68, 0xd7, 0xaf, 0xb2, 0xbc, 0x2b, 0x18, 0xec, 0x5a, 0xea, 0x8c, 0x48,
+	0x14, 0xe5, 0x54, 0x08, 0xf3, 0xa7, 0x55, 0xa8, 0x58, 0xbb, 0xaf, 0x59, 0x73, 0x7c, 0x13, 0x79,
+	0xef, 0x60, 0x70, 0xb5, 0x66, 0xcb, 0xa8, 0xa6, 0xf4, 0x09, 0xb8, 0x11, 0x15, 0x61, 0xce, 0x32,
+	0x05, 0xbb, 0xae, 0xe3, 0xce, 0x4e, 0x4c, 0x2b, 0xf3, 0xe2, 0xba, 0xf6, 0xfc, 0xed, 0xc4, 0x5f,
+	0xd2, 0xfc, 0xb5, 0x01, 0xfd, 0x9d, 0x6d, 0x35, 0xa2, 0x96, 0x4e, 0x3c, 0x80, 0xa8, 0xaa, 0xd0,
+	0xaf, 0x11, 0xdd, 0x01, 0xb2, 0xa9, 0xbd, 0xc3, 0x40, 0xb6, 0x0e, 0x03, 0xd9, 0xde, 0x02, 0xb2,
+	0x62, 0xae, 0xf3, 0x97, 0xcc, 0x75, 0x7f, 0xcf, 0x9c, 0xfd, 0x27, 0xcc, 0x39, 0xba, 0xf5, 0x3d,
+	0xe6, 0x86, 0x5b, 0xcc, 0x41, 0x79, 0x41, 0xaa, 0x78, 0x73, 0xc1, 0xdd, 0xfa, 0x82, 0x7b, 0x1f,
+	0xe0, 0x68, 0x33, 0x31, 0xf3, 0x36, 0xec, 0x3f, 0x2c, 0xd5, 0x5b, 0xd1, 0xd8, 0x7a, 0x2b, 0x0e,
+	0xbf, 0x0b, 0xe6, 0xf2, 0xb7, 0xea, 0xcb, 0x7f, 0x01, 0x10, 0xf2, 0xd5, 0x8a, 0x09, 0xa1, 0xa6,
+	0x5f, 0x9e, 0xdc, 0x96, 0x32, 0xfb, 0x62, 0x81, 0x33, 0x2f, 0x6e, 0xca, 0xef, 0x44, 0xaf, 0xc1,
+	0xae, 0x10, 0x44, 0xa7, 0x7b, 0xb8, 0x1a, 0xa0, 0x86, 0x67, 0x3f, 0xe9, 0x65, 0xdb, 0x1e, 0xfa,
+	0xf8, 0xed, 0xfb, 0xa7, 0x46, 0x0f, 0xc1, 0x34, 0xd2, 0x56, 0x20, 0x0b, 0xf4, 0x16, 0xba, 0xe6,
+	0xeb, 0x50, 0x85, 0xff, 0x2e, 0x9f, 0xc3, 0xd3, 0x7d, 0xd9, 0x54, 0xbb, 0xd0, 0xd5, 0xb0, 0xe7,
+	0x4c, 0x17, 0xca, 0x09, 0x64, 0xf1, 0x6c, 0x9b, 0xcf, 0x45, 0x47, 0x8f, 0xf8, 0xf2, 0xc7, 0x00,
+	0x0f, 0x1d, 0x20, 0x39, 0xe6, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TxServiceClient is the client API for TxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TxServiceClient interface {
+	DecodeTx(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error)
+	BuildTx(ctx context.Context, in *BuildTxRequest, opts ...grpc.CallOption) (*BuildTxResponse, error)
+}
+
+type txServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxServiceClient(cc grpc.ClientConnInterface) TxServiceClient {
+	return &txServiceClient{cc}
+}
+
+func (c *txServiceClient) DecodeTx(ctx context.Context, in *DecodeTxRequest, opts ...grpc.CallOption) (*DecodeTxResponse, error) {
+	out := new(DecodeTxResponse)
+	err := c.cc.Invoke(ctx, "/tx_pb.TxService/DecodeTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) BuildTx(ctx context.Context, in *BuildTxRequest, opts ...grpc.CallOption) (*BuildTxResponse, error) {
+	out := new(BuildTxResponse)
+	err := c.cc.Invoke(ctx, "/tx_pb.TxService/BuildTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxServiceServer is the server API for TxService service.
+type TxServiceServer interface {
+	DecodeTx(context.Context, *DecodeTxRequest) (*DecodeTxResponse, error)
+	BuildTx(context.Context, *BuildTxRequest) (*BuildTxResponse, error)
+}
+
+// UnimplementedTxServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTxServiceServer struct {
+}
+
+func (*UnimplementedTxServiceServer) DecodeTx(ctx context.Context, req *DecodeTxRequest) (*DecodeTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTx not implemented")
+}
+func (*UnimplementedTxServiceServer) BuildTx(ctx context.Context, req *BuildTxRequest) (*BuildTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildTx not implemented")
+}
+
+func RegisterTxServiceServer(s *grpc.Server, srv TxServiceServer) {
+	s.RegisterService(&_TxService_serviceDesc, srv)
+}
+
+func _TxService_DecodeTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).DecodeTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx_pb.TxService/DecodeTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).DecodeTx(ctx, req.(*DecodeTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_BuildTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).BuildTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx_pb.TxService/BuildTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).BuildTx(ctx, req.(*BuildTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TxService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tx_pb.TxService",
+	HandlerType: (*TxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DecodeTx",
+			Handler:    _TxService_DecodeTx_Handler,
+		},
+		{
+			MethodName: "BuildTx",
+			Handler:    _TxService_BuildTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tx.proto",
+}
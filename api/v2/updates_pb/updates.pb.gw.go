@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: updates.proto
+
+/*
+Package updates_pb is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package updates_pb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+// Suppress "imported and not used" errors
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+var _ = descriptor.ForMessage
+
+var (
+	filter_UpdatesService_SubscribeUpdates_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_UpdatesService_SubscribeUpdates_0(ctx context.Context, marshaler runtime.Marshaler, client UpdatesServiceClient, req *http.Request, pathParams map[string]string) (UpdatesService_SubscribeUpdatesClient, runtime.ServerMetadata, error) {
+	var protoReq SubscribeUpdatesRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_UpdatesService_SubscribeUpdates_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.SubscribeUpdates(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+// RegisterUpdatesServiceHandlerServer registers the http handlers for service UpdatesService to "mux".
+// UnaryRPC     :call UpdatesServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+func RegisterUpdatesServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server UpdatesServiceServer) error {
+
+	mux.Handle("GET", pattern_UpdatesService_SubscribeUpdates_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	return nil
+}
+
+// RegisterUpdatesServiceHandlerFromEndpoint is same as RegisterUpdatesServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterUpdatesServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterUpdatesServiceHandler(ctx, mux, conn)
+}
+
+// RegisterUpdatesServiceHandler registers the http handlers for service UpdatesService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterUpdatesServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterUpdatesServiceHandlerClient(ctx, mux, NewUpdatesServiceClient(conn))
+}
+
+// RegisterUpdatesServiceHandlerClient registers the http handlers for service UpdatesService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "UpdatesServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "UpdatesServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "UpdatesServiceClient" to call the correct interceptors.
+func RegisterUpdatesServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client UpdatesServiceClient) error {
+
+	mux.Handle("GET", pattern_UpdatesService_SubscribeUpdates_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_UpdatesService_SubscribeUpdates_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_UpdatesService_SubscribeUpdates_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_UpdatesService_SubscribeUpdates_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"subscribe_updates"}, "", runtime.AssumeColonVerbOpt(true)))
+)
+
+var (
+	forward_UpdatesService_SubscribeUpdates_0 = runtime.ForwardResponseStream
+)
@@ -2,7 +2,15 @@ package v2
 
 import (
 	"context"
+	"github.com/MinterTeam/minter-go-node/api/limiter"
+	"github.com/MinterTeam/minter-go-node/api/v2/candidate_pb"
+	"github.com/MinterTeam/minter-go-node/api/v2/events_pb"
+	"github.com/MinterTeam/minter-go-node/api/v2/export_pb"
+	"github.com/MinterTeam/minter-go-node/api/v2/payloads_pb"
+	"github.com/MinterTeam/minter-go-node/api/v2/rewards_pb"
 	"github.com/MinterTeam/minter-go-node/api/v2/service"
+	"github.com/MinterTeam/minter-go-node/api/v2/tx_pb"
+	"github.com/MinterTeam/minter-go-node/api/v2/updates_pb"
 	gw "github.com/MinterTeam/node-grpc-gateway/api_pb"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -13,17 +21,27 @@ import (
 	"net/http"
 )
 
-func Run(srv *service.Service, addrGRPC, addrApi string) error {
+// maxRequestBodySize limits the size of request bodies
+const maxRequestBodySize = 1 << 20
+
+func Run(srv *service.Service, addrGRPC, addrApi string, apiLimiter *limiter.Limiter) error {
 	lis, err := net.Listen("tcp", addrGRPC)
 	if err != nil {
 		return err
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
-		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
+		grpc.StreamInterceptor(chainStreamInterceptors(grpc_prometheus.StreamServerInterceptor, apiLimiter.StreamServerInterceptor)),
+		grpc.UnaryInterceptor(chainUnaryInterceptors(grpc_prometheus.UnaryServerInterceptor, apiLimiter.UnaryServerInterceptor, srv.CacheInterceptor)),
 	)
 	gw.RegisterApiServiceServer(grpcServer, srv)
+	updates_pb.RegisterUpdatesServiceServer(grpcServer, srv)
+	export_pb.RegisterExportServiceServer(grpcServer, srv)
+	rewards_pb.RegisterRewardsServiceServer(grpcServer, srv)
+	candidate_pb.RegisterCandidateServiceServer(grpcServer, srv)
+	payloads_pb.RegisterPayloadsServiceServer(grpcServer, srv)
+	tx_pb.RegisterTxServiceServer(grpcServer, srv)
+	events_pb.RegisterEventsServiceServer(grpcServer, srv)
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc_prometheus.Register(grpcServer)
 
@@ -36,7 +54,10 @@ func Run(srv *service.Service, addrGRPC, addrApi string) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}))
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(apiLimiter.HeaderMatcher),
+	)
 	opts := []grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(50000000)),
@@ -46,11 +67,65 @@ func Run(srv *service.Service, addrGRPC, addrApi string) error {
 		return gw.RegisterApiServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
 	})
 	group.Go(func() error {
-		return http.ListenAndServe(addrApi, mux)
+		return rewards_pb.RegisterRewardsServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
+	})
+	group.Go(func() error {
+		return candidate_pb.RegisterCandidateServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
 	})
 	group.Go(func() error {
-		return http.ListenAndServe(addrApi, wsproxy.WebsocketProxy(mux))
+		return payloads_pb.RegisterPayloadsServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
+	})
+	group.Go(func() error {
+		return tx_pb.RegisterTxServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
+	})
+	group.Go(func() error {
+		return events_pb.RegisterEventsServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
+	})
+	group.Go(func() error {
+		return updates_pb.RegisterUpdatesServiceHandlerFromEndpoint(ctx, mux, addrGRPC, opts)
+	})
+	group.Go(func() error {
+		return http.ListenAndServe(addrApi, limitRequestBody(mux))
+	})
+	group.Go(func() error {
+		return http.ListenAndServe(addrApi, limitRequestBody(wsproxy.WebsocketProxy(mux)))
 	})
 
 	return group.Wait()
 }
+
+// limitRequestBody limits the size of bodies of requests decoded by the gateway, e.g. build_tx
+func limitRequestBody(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+		h.ServeHTTP(w, r)
+	})
+}
+
+// chainUnaryInterceptors composes interceptors, the first one is the outermost
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// chainStreamInterceptors composes interceptors, the first one is the outermost
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
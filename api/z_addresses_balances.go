@@ -63,10 +63,11 @@ return result
 
 func MakeAddressBalance(height int, address types.Address) (*AddressBalanceResponse,
 	error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 	
 	if height != 0 {
 		cState.Lock()
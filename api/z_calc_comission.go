@@ -53,10 +53,11 @@ func CalcTxCommission(gascoin string, height int, txtype string, payload string,
 
 	totalCommissionInBaseCoin := new(big.Int).Add(commissionfreepayload,comissionpayload)
 
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return "", err
 	}
+	defer release()
 
 	cState.RLock() 
 	defer cState.RUnlock()
@@ -70,7 +70,7 @@ func ResponseCandidateAlt(state *state.State, c candidates.Candidate) CandidateR
 }
 
 func CandidateAlt(pubkey types.Pubkey, height int) (*CandidateResponseAlt, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	} 
@@ -82,6 +82,7 @@ func CandidateAlt(pubkey types.Pubkey, height int) (*CandidateResponseAlt, error
 	 	cState.Validators.LoadValidators()
 		cState.Unlock()
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
@@ -96,10 +97,11 @@ func CandidateAlt(pubkey types.Pubkey, height int) (*CandidateResponseAlt, error
 }
 
 func CandidatesAlt(height int, status int) (*[]CandidateResponseAlt, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	if height != 0 {
 		cState.Lock()
@@ -24,6 +24,10 @@ func FindEvents(height uint64, find []string) (*FindEventsResponse , error) {
 		}
 	}
 
+	if err := blockchain.CheckEventsHeight(height); err != nil {
+		return nil, err
+	}
+
 	events:=blockchain.GetEventsDB().LoadEvents(uint32(height))
 	
 	for _, event:= range events{
@@ -66,10 +66,11 @@ func GetStakes(pubkey types.Pubkey, height int, coin string, address types.Addre
 	var tmpubkey types.Pubkey
 	var candidates []*candidates.Candidate
 
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	if height != 0 {
 		cState.Lock()
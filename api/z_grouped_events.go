@@ -30,6 +30,10 @@ func GroupedEvents(height int64) (*[]EventsResponseValidator, error) {
 		height = int64(blockchain.Height())
 	}
 
+	if err := blockchain.CheckEventsHeight(uint64(height)); err != nil {
+		return nil, err
+	}
+
 	tmVals, err := client.Validators(&height, 1, 256)
 	if err != nil {
 		return nil, err
@@ -5,10 +5,11 @@ import (
 
 
 func TotalSlashed(height int) (*big.Int, error) {
-	cState, err := GetStateForHeight(height)
+	cState, release, err := GetStateForHeight(height)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	cState.RLock()
 	defer cState.RUnlock()
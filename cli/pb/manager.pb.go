@@ -635,6 +635,7 @@ type StatusResponse struct {
 	LatestBlockTime      string                   `protobuf:"bytes,4,opt,name=latest_block_time,json=latestBlockTime,proto3" json:"latest_block_time"`
 	KeepLastStates       int64                    `protobuf:"varint,5,opt,name=keep_last_states,json=keepLastStates,proto3" json:"keep_last_states"`
 	TmStatus             *StatusResponse_TmStatus `protobuf:"bytes,6,opt,name=tm_status,json=tmStatus,proto3" json:"tm_status"`
+	Health               *StatusResponse_Health   `protobuf:"bytes,8,opt,name=health,proto3" json:"health"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -714,6 +715,13 @@ func (m *StatusResponse) GetTmStatus() *StatusResponse_TmStatus {
 	return nil
 }
 
+func (m *StatusResponse) GetHealth() *StatusResponse_Health {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
 type StatusResponse_TmStatus struct {
 	NodeInfo             *NodeInfo                              `protobuf:"bytes,3,opt,name=node_info,json=nodeInfo,proto3" json:"node_info"`
 	SyncInfo             *StatusResponse_TmStatus_SyncInfo      `protobuf:"bytes,1,opt,name=sync_info,json=syncInfo,proto3" json:"sync_info"`
@@ -946,6 +954,108 @@ func (m *StatusResponse_TmStatus_ValidatorInfo_PubKey) GetValue() string {
 	return ""
 }
 
+type StatusResponse_Health struct {
+	Ready                bool                           `protobuf:"varint,1,opt,name=ready,proto3" json:"ready"`
+	Checks               []*StatusResponse_Health_Check `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *StatusResponse_Health) Reset()         { *m = StatusResponse_Health{} }
+func (m *StatusResponse_Health) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse_Health) ProtoMessage()    {}
+func (*StatusResponse_Health) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2, 1}
+}
+
+func (m *StatusResponse_Health) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusResponse_Health.Unmarshal(m, b)
+}
+func (m *StatusResponse_Health) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusResponse_Health.Marshal(b, m, deterministic)
+}
+func (m *StatusResponse_Health) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse_Health.Merge(m, src)
+}
+func (m *StatusResponse_Health) XXX_Size() int {
+	return xxx_messageInfo_StatusResponse_Health.Size(m)
+}
+func (m *StatusResponse_Health) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse_Health.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusResponse_Health proto.InternalMessageInfo
+
+func (m *StatusResponse_Health) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *StatusResponse_Health) GetChecks() []*StatusResponse_Health_Check {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+type StatusResponse_Health_Check struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Ok                   bool     `protobuf:"varint,2,opt,name=ok,proto3" json:"ok"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusResponse_Health_Check) Reset()         { *m = StatusResponse_Health_Check{} }
+func (m *StatusResponse_Health_Check) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse_Health_Check) ProtoMessage()    {}
+func (*StatusResponse_Health_Check) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2, 1, 0}
+}
+
+func (m *StatusResponse_Health_Check) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusResponse_Health_Check.Unmarshal(m, b)
+}
+func (m *StatusResponse_Health_Check) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusResponse_Health_Check.Marshal(b, m, deterministic)
+}
+func (m *StatusResponse_Health_Check) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse_Health_Check.Merge(m, src)
+}
+func (m *StatusResponse_Health_Check) XXX_Size() int {
+	return xxx_messageInfo_StatusResponse_Health_Check.Size(m)
+}
+func (m *StatusResponse_Health_Check) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse_Health_Check.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusResponse_Health_Check proto.InternalMessageInfo
+
+func (m *StatusResponse_Health_Check) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StatusResponse_Health_Check) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *StatusResponse_Health_Check) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type PruneBlocksRequest struct {
 	FromHeight           int64    `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height"`
 	ToHeight             int64    `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height"`
@@ -1054,6 +1164,8 @@ func init() {
 	proto.RegisterType((*StatusResponse_TmStatus_SyncInfo)(nil), "pb.StatusResponse.TmStatus.SyncInfo")
 	proto.RegisterType((*StatusResponse_TmStatus_ValidatorInfo)(nil), "pb.StatusResponse.TmStatus.ValidatorInfo")
 	proto.RegisterType((*StatusResponse_TmStatus_ValidatorInfo_PubKey)(nil), "pb.StatusResponse.TmStatus.ValidatorInfo.PubKey")
+	proto.RegisterType((*StatusResponse_Health)(nil), "pb.StatusResponse.Health")
+	proto.RegisterType((*StatusResponse_Health_Check)(nil), "pb.StatusResponse.Health.Check")
 	proto.RegisterType((*PruneBlocksRequest)(nil), "pb.PruneBlocksRequest")
 	proto.RegisterType((*DealPeerRequest)(nil), "pb.DealPeerRequest")
 }
@@ -1061,94 +1173,99 @@ func init() {
 func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
 
 var fileDescriptor_cde9ec64f0d2c859 = []byte{
-	// 1235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x8e, 0x1b, 0x45,
-	0x17, 0x95, 0xdd, 0xfe, 0x69, 0x5f, 0x8f, 0xe7, 0xa7, 0xf2, 0x29, 0x9f, 0xe9, 0x89, 0xc8, 0x30,
-	0x8a, 0x90, 0x83, 0x50, 0x07, 0x0c, 0x02, 0x36, 0x2c, 0x26, 0x33, 0x08, 0xac, 0x90, 0xc4, 0x94,
-	0x43, 0xb6, 0xad, 0x72, 0xfb, 0x8e, 0xdd, 0x9a, 0x76, 0x55, 0xd1, 0x5d, 0xed, 0xc4, 0x3c, 0x06,
-	0x8f, 0x81, 0x84, 0x58, 0xf2, 0x10, 0xac, 0x59, 0xf1, 0x1e, 0x91, 0xd8, 0xa1, 0xfa, 0x69, 0xc7,
-	0x3d, 0x93, 0x89, 0x32, 0xac, 0x5c, 0xe7, 0xdc, 0xba, 0xc7, 0xb7, 0x4e, 0x55, 0xdd, 0x2e, 0xe8,
-	0x2d, 0x19, 0x67, 0x73, 0xcc, 0x42, 0x99, 0x09, 0x25, 0x48, 0x5d, 0x4e, 0x83, 0xc3, 0xb9, 0x10,
-	0xf3, 0x14, 0x1f, 0x18, 0x66, 0x5a, 0x9c, 0x3f, 0xc0, 0xa5, 0x54, 0x6b, 0x3b, 0xe1, 0xf8, 0x57,
-	0x0f, 0xfc, 0x27, 0x62, 0x86, 0x23, 0x7e, 0x2e, 0xc8, 0xb7, 0xb0, 0x6f, 0xd8, 0x58, 0xa4, 0xd1,
-	0x0a, 0xb3, 0x3c, 0x11, 0xbc, 0xef, 0x1f, 0xd5, 0x06, 0xdd, 0xe1, 0x9d, 0x50, 0x4e, 0xc3, 0x72,
-	0x5e, 0x38, 0x76, 0x93, 0x9e, 0xdb, 0x39, 0x74, 0x4f, 0x56, 0x09, 0xb2, 0x0b, 0xf5, 0x64, 0xd6,
-	0xaf, 0x1d, 0xd5, 0x06, 0x1d, 0x5a, 0x4f, 0x66, 0xe4, 0x2e, 0x74, 0xd3, 0x24, 0x57, 0xc8, 0x23,
-	0x36, 0x9b, 0x65, 0xfd, 0xba, 0x09, 0x80, 0xa5, 0x4e, 0x66, 0xb3, 0x8c, 0xf4, 0xa1, 0xcd, 0x51,
-	0xbd, 0x10, 0xd9, 0x45, 0xdf, 0x33, 0xc1, 0x12, 0xea, 0x48, 0x59, 0x4a, 0xc3, 0x46, 0x1c, 0x24,
-	0x01, 0xf8, 0xf1, 0x82, 0x71, 0x8e, 0x69, 0xde, 0x6f, 0x9a, 0xd0, 0x06, 0xeb, 0xac, 0xa5, 0xe0,
-	0xc9, 0x05, 0x66, 0xfd, 0x96, 0xcd, 0x72, 0x90, 0x0c, 0xa0, 0x29, 0xd4, 0x02, 0xb3, 0x7e, 0xdb,
-	0x2c, 0x8c, 0x54, 0x16, 0xf6, 0x54, 0x47, 0xa8, 0x9d, 0x10, 0x3c, 0x82, 0xbd, 0x4b, 0x0b, 0x25,
-	0xfb, 0xe0, 0xc9, 0xa1, 0x34, 0x25, 0x36, 0xa8, 0x1e, 0x92, 0xff, 0x41, 0x73, 0x9a, 0x8a, 0xf8,
-	0xc2, 0x2c, 0xb6, 0x41, 0x2d, 0xd0, 0xf3, 0x98, 0x94, 0x66, 0x9d, 0x0d, 0xaa, 0x87, 0xc1, 0x29,
-	0x34, 0x8d, 0x38, 0x79, 0x0f, 0x7c, 0xf5, 0x32, 0x4a, 0xf8, 0x0c, 0x5f, 0x3a, 0x1f, 0xda, 0xea,
-	0xe5, 0x48, 0x43, 0xed, 0x52, 0x26, 0x63, 0x63, 0x11, 0xe6, 0xb9, 0xb3, 0x0f, 0x32, 0x19, 0x9f,
-	0x58, 0xe6, 0xf8, 0x97, 0x0e, 0xec, 0x3d, 0x41, 0xa5, 0x4b, 0xa5, 0x98, 0x4b, 0xc1, 0x73, 0x24,
-	0x77, 0xa0, 0x63, 0x7d, 0x4c, 0xf8, 0xdc, 0x38, 0xe4, 0xd3, 0xd7, 0xc4, 0xeb, 0x28, 0x66, 0x5a,
-	0xd0, 0x1b, 0x74, 0xe8, 0x6b, 0x82, 0xfc, 0x1f, 0xda, 0x3c, 0x92, 0xa8, 0x63, 0xba, 0x14, 0x8f,
-	0xb6, 0xf8, 0x58, 0x23, 0x12, 0x42, 0xd3, 0xd2, 0xde, 0x91, 0x37, 0xe8, 0x0e, 0xfb, 0xc6, 0xa4,
-	0xea, 0x1f, 0x87, 0x7a, 0x26, 0xb5, 0xd3, 0x82, 0x7f, 0xda, 0xd0, 0xd0, 0x98, 0xdc, 0x87, 0x0e,
-	0x17, 0x33, 0x8c, 0x12, 0x7e, 0x2e, 0x4c, 0x35, 0xdd, 0xe1, 0xce, 0xb6, 0xc3, 0xd4, 0xe7, 0xe5,
-	0x61, 0xbb, 0x0b, 0xdd, 0x24, 0x8f, 0x44, 0xa1, 0xa6, 0xa2, 0xe0, 0xf6, 0xb0, 0xf8, 0x14, 0x92,
-	0xfc, 0xa9, 0x63, 0xc8, 0x73, 0x38, 0x88, 0x05, 0xe7, 0x18, 0xab, 0x44, 0xf0, 0x28, 0x57, 0x4c,
-	0x15, 0xb6, 0xce, 0xee, 0xf0, 0xfe, 0x75, 0x05, 0x85, 0xa7, 0x9b, 0x8c, 0x89, 0x49, 0xa0, 0xfb,
-	0xf1, 0x25, 0x86, 0x1c, 0x42, 0x27, 0xc3, 0xa5, 0x50, 0x18, 0x25, 0xd2, 0x9d, 0x36, 0xdf, 0x12,
-	0x23, 0x19, 0xfc, 0xde, 0x82, 0xfd, 0xcb, 0x1a, 0xfa, 0xa4, 0x9d, 0x15, 0x19, 0x53, 0xe5, 0x21,
-	0xf4, 0xe8, 0x06, 0x93, 0x09, 0x74, 0x27, 0xc8, 0x67, 0x8f, 0x05, 0x4f, 0x94, 0xc8, 0xcc, 0x32,
-	0xba, 0xc3, 0x4f, 0xdf, 0xb9, 0xbe, 0xd0, 0x25, 0xd2, 0x6d, 0x15, 0x2d, 0x4a, 0x31, 0x5e, 0x95,
-	0xa2, 0xf5, 0xff, 0x2c, 0xba, 0xa5, 0x42, 0x1e, 0x83, 0x7f, 0x5a, 0xde, 0x17, 0xbb, 0xaf, 0x37,
-	0x50, 0x74, 0x99, 0x74, 0x23, 0x11, 0xfc, 0x55, 0x87, 0x76, 0x29, 0x7d, 0x1b, 0x5a, 0x27, 0xb1,
-	0x4a, 0x56, 0xd8, 0xef, 0x99, 0x6d, 0x74, 0x48, 0xdf, 0x8e, 0x89, 0x62, 0x99, 0x72, 0x67, 0xd9,
-	0x82, 0x8a, 0x9d, 0xf5, 0x4b, 0x76, 0x12, 0x68, 0x8c, 0x66, 0x29, 0x9a, 0x7d, 0xf1, 0xa8, 0x19,
-	0x6b, 0x95, 0x87, 0x6b, 0x85, 0xb9, 0xf3, 0xde, 0x02, 0x7d, 0xc5, 0x27, 0x6c, 0x29, 0x53, 0xb4,
-	0xb7, 0xdf, 0xa3, 0x25, 0xd4, 0xfa, 0x23, 0x9e, 0x2b, 0xca, 0x14, 0x9a, 0xdb, 0xef, 0xd1, 0x0d,
-	0xd6, 0x59, 0xa7, 0x45, 0x66, 0x42, 0x6d, 0x9b, 0xe5, 0xa0, 0x8e, 0x9c, 0xac, 0xe6, 0x26, 0xe2,
-	0xdb, 0x88, 0x83, 0x5a, 0x6f, 0x8c, 0xec, 0xc2, 0x84, 0x3a, 0x56, 0xaf, 0xc4, 0x3a, 0x66, 0xca,
-	0xa1, 0xb8, 0xec, 0x83, 0x8d, 0x95, 0x58, 0x2b, 0x3e, 0x4b, 0x96, 0xa8, 0x43, 0x5d, 0xab, 0xe8,
-	0xa0, 0x51, 0xcc, 0xc4, 0xdc, 0x5c, 0xf3, 0x9d, 0xa3, 0xda, 0xa0, 0x47, 0x37, 0x38, 0xf8, 0xad,
-	0x06, 0x6d, 0x67, 0xb2, 0xee, 0xa3, 0xa3, 0x33, 0xb3, 0xbc, 0x26, 0xad, 0x8f, 0xce, 0xc8, 0xc7,
-	0x70, 0xa0, 0x8f, 0xc9, 0x0f, 0x05, 0x16, 0x78, 0xca, 0x24, 0x8b, 0x13, 0xb5, 0x36, 0xde, 0x7a,
-	0xf4, 0x6a, 0x80, 0xdc, 0x83, 0xde, 0x86, 0x9c, 0x24, 0x3f, 0xa3, 0x33, 0xbb, 0x4a, 0xda, 0x5a,
-	0x12, 0x91, 0x69, 0x29, 0xcf, 0xad, 0xce, 0x61, 0x72, 0x0c, 0x3b, 0x14, 0x63, 0xe4, 0x2a, 0x5d,
-	0x4f, 0x90, 0x2b, 0xb7, 0x01, 0x15, 0xee, 0xf8, 0x8f, 0x36, 0xec, 0xba, 0xbb, 0x56, 0xf6, 0xa4,
-	0xad, 0x9e, 0xdd, 0xae, 0xf6, 0xec, 0x8f, 0xe0, 0x20, 0x65, 0x0a, 0x73, 0x15, 0x99, 0x46, 0x19,
-	0x2d, 0x58, 0xbe, 0x70, 0x87, 0x63, 0xcf, 0x06, 0x1e, 0x6a, 0xfe, 0x3b, 0x96, 0x2f, 0xc8, 0x87,
-	0xe0, 0xa8, 0x88, 0x49, 0x69, 0x67, 0xda, 0x86, 0xd9, 0xb3, 0xf4, 0x89, 0x94, 0x66, 0x5e, 0x08,
-	0xb7, 0xaa, 0x9a, 0x98, 0xcc, 0x17, 0xca, 0xad, 0xe5, 0x60, 0x5b, 0xd5, 0x04, 0xae, 0xd4, 0xa0,
-	0x92, 0x25, 0xba, 0x6f, 0xcb, 0x76, 0x0d, 0x7a, 0xaf, 0xc8, 0x00, 0xf6, 0x2f, 0x10, 0x65, 0x94,
-	0xb2, 0x5c, 0x99, 0x16, 0xb4, 0x39, 0x6d, 0xbb, 0x9a, 0xff, 0x9e, 0xe5, 0x6a, 0x62, 0x58, 0xf2,
-	0x15, 0x74, 0xd4, 0xb2, 0xec, 0x52, 0x2d, 0x73, 0x61, 0x0f, 0xf5, 0xf5, 0xaa, 0x5a, 0x13, 0x3e,
-	0x5b, 0x3a, 0xc2, 0x57, 0x6e, 0x14, 0xbc, 0x6a, 0x80, 0x5f, 0xd2, 0xd5, 0x06, 0xea, 0xbd, 0xb5,
-	0x81, 0x9e, 0x40, 0x27, 0x5f, 0xf3, 0xd8, 0x4e, 0xb5, 0x7d, 0xe7, 0xde, 0x5b, 0xfe, 0x31, 0x9c,
-	0xac, 0x79, 0x6c, 0x25, 0x72, 0x37, 0x22, 0x63, 0xd8, 0x5d, 0xb1, 0x34, 0x99, 0x31, 0x25, 0x32,
-	0xab, 0xb3, 0xd5, 0x5f, 0xaf, 0xd3, 0x79, 0x5e, 0x66, 0x18, 0xb1, 0xde, 0x6a, 0x1b, 0x06, 0x7f,
-	0xd7, 0xc0, 0x2f, 0xff, 0xe8, 0xcd, 0xbb, 0xdd, 0x7c, 0xe7, 0xdd, 0xae, 0xdd, 0x60, 0xb7, 0xeb,
-	0x37, 0xda, 0x6d, 0xef, 0xcd, 0xbb, 0x7d, 0x17, 0xba, 0x31, 0x53, 0xf1, 0x22, 0xe1, 0xf3, 0xa8,
-	0x90, 0xee, 0x6b, 0x0a, 0x25, 0xf5, 0xa3, 0x0c, 0xfe, 0xac, 0x41, 0xaf, 0xb2, 0x7c, 0x7d, 0xd4,
-	0xcb, 0xef, 0xb5, 0x7b, 0xb8, 0x38, 0x48, 0x46, 0xd0, 0x96, 0xc5, 0x34, 0xba, 0xc0, 0xb5, 0xdb,
-	0x9c, 0x4f, 0xde, 0xd9, 0xd4, 0x70, 0x5c, 0x4c, 0x1f, 0xe1, 0x9a, 0xb6, 0xa4, 0xf9, 0x25, 0x1f,
-	0xc0, 0xce, 0x4a, 0x28, 0x5d, 0x95, 0x14, 0x2f, 0x30, 0x73, 0x8b, 0xed, 0x5a, 0x6e, 0xac, 0xa9,
-	0x60, 0x08, 0x2d, 0x9b, 0xa4, 0x3b, 0xa8, 0x5a, 0x4b, 0x74, 0x77, 0xc5, 0x8c, 0x75, 0x07, 0x5d,
-	0xb1, 0xb4, 0xc0, 0xb2, 0x0f, 0x1b, 0x70, 0x4c, 0x81, 0x8c, 0xb3, 0x82, 0xa3, 0x31, 0x20, 0xa7,
-	0xf8, 0x53, 0x81, 0xb9, 0xd2, 0x26, 0x9c, 0x67, 0x62, 0x59, 0x1a, 0x6b, 0xbb, 0x0b, 0x68, 0xca,
-	0x39, 0x7a, 0x08, 0x1d, 0x25, 0xaa, 0xbe, 0xfb, 0x4a, 0xd8, 0xe0, 0xf1, 0x23, 0xd8, 0x3b, 0x43,
-	0x96, 0x9a, 0xc7, 0x81, 0x13, 0xdc, 0xb2, 0xa8, 0x56, 0xb5, 0xe8, 0x7d, 0x00, 0xa9, 0x1b, 0x83,
-	0x7e, 0x8f, 0x58, 0x29, 0x9f, 0x6e, 0x31, 0xc3, 0x57, 0x35, 0xd8, 0x7d, 0x6c, 0xdf, 0xb3, 0x13,
-	0xcc, 0x56, 0x49, 0x8c, 0xe4, 0x73, 0x68, 0xb9, 0x9b, 0x72, 0x3b, 0xb4, 0xef, 0xda, 0xb0, 0x7c,
-	0xd7, 0x86, 0xdf, 0xe8, 0x77, 0x6d, 0x40, 0xae, 0xda, 0x4c, 0xbe, 0x80, 0xb6, 0xfb, 0xcc, 0x5d,
-	0x9b, 0x76, 0xeb, 0x0d, 0xdf, 0x42, 0xf2, 0x35, 0x74, 0xb7, 0x1c, 0x22, 0xb7, 0xf5, 0x9c, 0xab,
-	0x96, 0x05, 0xd7, 0x68, 0x92, 0x2f, 0xc1, 0x2f, 0xcd, 0x20, 0x46, 0xff, 0x92, 0x35, 0xd7, 0x25,
-	0x4e, 0x5b, 0x06, 0x7f, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x91, 0x0e, 0xb5, 0xce,
-	0x0b, 0x00, 0x00,
+	// 1322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x8e, 0xdb, 0xc6,
+	0x12, 0x85, 0x44, 0x3d, 0xa8, 0xd2, 0x68, 0x1e, 0xed, 0x0b, 0x5f, 0x99, 0x63, 0x5c, 0xcf, 0x1d,
+	0x18, 0x17, 0xf2, 0x45, 0x40, 0xc7, 0x4a, 0x10, 0x67, 0x93, 0xc5, 0x78, 0xc6, 0x88, 0x05, 0xc7,
+	0xb6, 0xd2, 0x72, 0xbc, 0x25, 0x5a, 0x54, 0x8d, 0x44, 0x88, 0xea, 0xee, 0x90, 0x4d, 0xd9, 0xca,
+	0x2f, 0x64, 0x97, 0x0f, 0xc8, 0x07, 0x04, 0x08, 0xf2, 0x21, 0x59, 0x67, 0x95, 0xff, 0x30, 0x90,
+	0x5d, 0xd0, 0x0f, 0xca, 0xd2, 0x3c, 0x0c, 0x3b, 0x2b, 0xf5, 0x39, 0x55, 0x7d, 0x54, 0x5d, 0x55,
+	0x5d, 0x4d, 0xe8, 0x2c, 0x18, 0x67, 0x53, 0xcc, 0x42, 0x99, 0x09, 0x25, 0x48, 0x55, 0x8e, 0x83,
+	0xc3, 0xa9, 0x10, 0xd3, 0x14, 0xef, 0x1b, 0x66, 0x5c, 0x9c, 0xdf, 0xc7, 0x85, 0x54, 0x2b, 0xeb,
+	0x70, 0xfc, 0x8b, 0x07, 0xfe, 0x73, 0x31, 0xc1, 0x01, 0x3f, 0x17, 0xe4, 0x6b, 0xd8, 0x37, 0x6c,
+	0x2c, 0xd2, 0x68, 0x89, 0x59, 0x9e, 0x08, 0xde, 0xf5, 0x8f, 0x2a, 0xbd, 0x76, 0xff, 0x76, 0x28,
+	0xc7, 0x61, 0xe9, 0x17, 0x0e, 0x9d, 0xd3, 0x2b, 0xeb, 0x43, 0xf7, 0xe4, 0x36, 0x41, 0x76, 0xa1,
+	0x9a, 0x4c, 0xba, 0x95, 0xa3, 0x4a, 0xaf, 0x45, 0xab, 0xc9, 0x84, 0xdc, 0x81, 0x76, 0x9a, 0xe4,
+	0x0a, 0x79, 0xc4, 0x26, 0x93, 0xac, 0x5b, 0x35, 0x06, 0xb0, 0xd4, 0xc9, 0x64, 0x92, 0x91, 0x2e,
+	0x34, 0x39, 0xaa, 0xd7, 0x22, 0x9b, 0x77, 0x3d, 0x63, 0x2c, 0xa1, 0xb6, 0x94, 0xa1, 0xd4, 0xac,
+	0xc5, 0x41, 0x12, 0x80, 0x1f, 0xcf, 0x18, 0xe7, 0x98, 0xe6, 0xdd, 0xba, 0x31, 0xad, 0xb1, 0xde,
+	0xb5, 0x10, 0x3c, 0x99, 0x63, 0xd6, 0x6d, 0xd8, 0x5d, 0x0e, 0x92, 0x1e, 0xd4, 0x85, 0x9a, 0x61,
+	0xd6, 0x6d, 0x9a, 0x83, 0x91, 0xad, 0x83, 0xbd, 0xd0, 0x16, 0x6a, 0x1d, 0x82, 0xa7, 0xb0, 0x77,
+	0xe1, 0xa0, 0x64, 0x1f, 0x3c, 0xd9, 0x97, 0x26, 0xc4, 0x1a, 0xd5, 0x4b, 0xf2, 0x2f, 0xa8, 0x8f,
+	0x53, 0x11, 0xcf, 0xcd, 0x61, 0x6b, 0xd4, 0x02, 0xed, 0xc7, 0xa4, 0x34, 0xe7, 0xac, 0x51, 0xbd,
+	0x0c, 0x4e, 0xa1, 0x6e, 0xc4, 0xc9, 0x2d, 0xf0, 0xd5, 0x9b, 0x28, 0xe1, 0x13, 0x7c, 0xe3, 0xf2,
+	0xd0, 0x54, 0x6f, 0x06, 0x1a, 0xea, 0x2c, 0x65, 0x32, 0x36, 0x29, 0xc2, 0x3c, 0x77, 0xe9, 0x83,
+	0x4c, 0xc6, 0x27, 0x96, 0x39, 0xfe, 0xa9, 0x05, 0x7b, 0xcf, 0x51, 0xe9, 0x50, 0x29, 0xe6, 0x52,
+	0xf0, 0x1c, 0xc9, 0x6d, 0x68, 0xd9, 0x3c, 0x26, 0x7c, 0x6a, 0x32, 0xe4, 0xd3, 0x77, 0xc4, 0x3b,
+	0x2b, 0x66, 0x5a, 0xd0, 0xeb, 0xb5, 0xe8, 0x3b, 0x82, 0xfc, 0x1b, 0x9a, 0x3c, 0x92, 0xa8, 0x6d,
+	0x3a, 0x14, 0x8f, 0x36, 0xf8, 0x50, 0x23, 0x12, 0x42, 0xdd, 0xd2, 0xde, 0x91, 0xd7, 0x6b, 0xf7,
+	0xbb, 0x26, 0x49, 0xdb, 0x7f, 0x1c, 0x6a, 0x4f, 0x6a, 0xdd, 0x82, 0xbf, 0x9a, 0x50, 0xd3, 0x98,
+	0xdc, 0x83, 0x16, 0x17, 0x13, 0x8c, 0x12, 0x7e, 0x2e, 0x4c, 0x34, 0xed, 0xfe, 0xce, 0x66, 0x86,
+	0xa9, 0xcf, 0xdd, 0x4a, 0x9f, 0x36, 0xc9, 0x23, 0x51, 0xa8, 0xb1, 0x28, 0xb8, 0x6d, 0x16, 0x9f,
+	0x42, 0x92, 0xbf, 0x70, 0x0c, 0x79, 0x05, 0x07, 0xb1, 0xe0, 0x1c, 0x63, 0x95, 0x08, 0x1e, 0xe5,
+	0x8a, 0xa9, 0xc2, 0xc6, 0xd9, 0xee, 0xdf, 0xbb, 0x2e, 0xa0, 0xf0, 0x74, 0xbd, 0x63, 0x64, 0x36,
+	0xd0, 0xfd, 0xf8, 0x02, 0x43, 0x0e, 0xa1, 0x95, 0xe1, 0x42, 0x28, 0x8c, 0x12, 0xe9, 0xba, 0xcd,
+	0xb7, 0xc4, 0x40, 0x06, 0xbf, 0x35, 0x60, 0xff, 0xa2, 0x86, 0xee, 0xb4, 0xb3, 0x22, 0x63, 0xaa,
+	0x6c, 0x42, 0x8f, 0xae, 0x31, 0x19, 0x41, 0x7b, 0x84, 0x7c, 0xf2, 0x4c, 0xf0, 0x44, 0x89, 0xcc,
+	0x1c, 0xa3, 0xdd, 0x7f, 0xf0, 0xc1, 0xf1, 0x85, 0x6e, 0x23, 0xdd, 0x54, 0xd1, 0xa2, 0x14, 0xe3,
+	0x65, 0x29, 0x5a, 0xfd, 0xc7, 0xa2, 0x1b, 0x2a, 0xe4, 0x19, 0xf8, 0xa7, 0xe5, 0x7d, 0xb1, 0x75,
+	0xfd, 0x08, 0x45, 0xb7, 0x93, 0xae, 0x25, 0x82, 0x3f, 0xaa, 0xd0, 0x2c, 0xa5, 0x6f, 0x42, 0xe3,
+	0x24, 0x56, 0xc9, 0x12, 0xbb, 0x1d, 0x53, 0x46, 0x87, 0xf4, 0xed, 0x18, 0x29, 0x96, 0x29, 0xd7,
+	0xcb, 0x16, 0x6c, 0xa5, 0xb3, 0x7a, 0x21, 0x9d, 0x04, 0x6a, 0x83, 0x49, 0x8a, 0xa6, 0x2e, 0x1e,
+	0x35, 0x6b, 0xad, 0xf2, 0x68, 0xa5, 0x30, 0x77, 0xb9, 0xb7, 0x40, 0x5f, 0xf1, 0x11, 0x5b, 0xc8,
+	0x14, 0xed, 0xed, 0xf7, 0x68, 0x09, 0xb5, 0xfe, 0x80, 0xe7, 0x8a, 0x32, 0x85, 0xe6, 0xf6, 0x7b,
+	0x74, 0x8d, 0xf5, 0xae, 0xd3, 0x22, 0x33, 0xa6, 0xa6, 0xdd, 0xe5, 0xa0, 0xb6, 0x9c, 0x2c, 0xa7,
+	0xc6, 0xe2, 0x5b, 0x8b, 0x83, 0x5a, 0x6f, 0x88, 0x6c, 0x6e, 0x4c, 0x2d, 0xab, 0x57, 0x62, 0x6d,
+	0x33, 0xe1, 0x50, 0x5c, 0x74, 0xc1, 0xda, 0x4a, 0xac, 0x15, 0x5f, 0x26, 0x0b, 0xd4, 0xa6, 0xb6,
+	0x55, 0x74, 0xd0, 0x28, 0x66, 0x62, 0x6a, 0xae, 0xf9, 0xce, 0x51, 0xa5, 0xd7, 0xa1, 0x6b, 0x1c,
+	0xfc, 0x5a, 0x81, 0xa6, 0x4b, 0xb2, 0x9e, 0xa3, 0x83, 0x33, 0x73, 0xbc, 0x3a, 0xad, 0x0e, 0xce,
+	0xc8, 0x27, 0x70, 0xa0, 0xdb, 0xe4, 0xdb, 0x02, 0x0b, 0x3c, 0x65, 0x92, 0xc5, 0x89, 0x5a, 0x99,
+	0xdc, 0x7a, 0xf4, 0xb2, 0x81, 0xdc, 0x85, 0xce, 0x9a, 0x1c, 0x25, 0x3f, 0xa0, 0x4b, 0xf6, 0x36,
+	0x69, 0x63, 0x49, 0x44, 0xa6, 0xa5, 0x3c, 0x77, 0x3a, 0x87, 0xc9, 0x31, 0xec, 0x50, 0x8c, 0x91,
+	0xab, 0x74, 0x35, 0x42, 0xae, 0x5c, 0x01, 0xb6, 0xb8, 0xe3, 0x1f, 0x5b, 0xb0, 0xeb, 0xee, 0x5a,
+	0x39, 0x93, 0x36, 0x66, 0x76, 0x73, 0x7b, 0x66, 0xff, 0x1f, 0x0e, 0x52, 0xa6, 0x30, 0x57, 0x91,
+	0x19, 0x94, 0xd1, 0x8c, 0xe5, 0x33, 0xd7, 0x1c, 0x7b, 0xd6, 0xf0, 0x48, 0xf3, 0x4f, 0x58, 0x3e,
+	0x23, 0xff, 0x03, 0x47, 0x45, 0x4c, 0x4a, 0xeb, 0x69, 0x07, 0x66, 0xc7, 0xd2, 0x27, 0x52, 0x1a,
+	0xbf, 0x10, 0x6e, 0x6c, 0x6b, 0x62, 0x32, 0x9d, 0x29, 0x77, 0x96, 0x83, 0x4d, 0x55, 0x63, 0xb8,
+	0x14, 0x83, 0x4a, 0x16, 0xe8, 0xde, 0x96, 0xcd, 0x18, 0x74, 0xad, 0x48, 0x0f, 0xf6, 0xe7, 0x88,
+	0x32, 0x4a, 0x59, 0xae, 0xcc, 0x08, 0x5a, 0x77, 0xdb, 0xae, 0xe6, 0xbf, 0x61, 0xb9, 0x1a, 0x19,
+	0x96, 0x7c, 0x09, 0x2d, 0xb5, 0x28, 0xa7, 0x54, 0xc3, 0x5c, 0xd8, 0x43, 0x7d, 0xbd, 0xb6, 0x53,
+	0x13, 0xbe, 0x5c, 0x38, 0xc2, 0x57, 0x6e, 0x45, 0x1e, 0x40, 0x63, 0x86, 0x2c, 0x55, 0x33, 0xf7,
+	0xd6, 0xde, 0xba, 0x62, 0xdb, 0x13, 0xe3, 0x40, 0x9d, 0x63, 0xf0, 0xb6, 0x06, 0x7e, 0xa9, 0xb4,
+	0x3d, 0x73, 0xbd, 0xf7, 0xce, 0xdc, 0x13, 0x68, 0xe5, 0x2b, 0x1e, 0x5b, 0x57, 0x3b, 0xaa, 0xee,
+	0xbe, 0x27, 0xc8, 0x70, 0xb4, 0xe2, 0xb1, 0x95, 0xc8, 0xdd, 0x8a, 0x0c, 0x61, 0x77, 0xc9, 0xd2,
+	0x64, 0xc2, 0x94, 0xc8, 0xac, 0xce, 0xc6, 0x48, 0xbe, 0x4e, 0xe7, 0x55, 0xb9, 0xc3, 0x88, 0x75,
+	0x96, 0x9b, 0x30, 0xf8, 0xb3, 0x02, 0x7e, 0xf9, 0x47, 0x57, 0x37, 0x48, 0xfd, 0x83, 0x1b, 0xa4,
+	0xf2, 0x11, 0x0d, 0x52, 0xfd, 0xa8, 0x06, 0xf1, 0xae, 0x6e, 0x90, 0x3b, 0xd0, 0x8e, 0x99, 0x8a,
+	0x67, 0x09, 0x9f, 0x46, 0x85, 0x74, 0x0f, 0x30, 0x94, 0xd4, 0x77, 0x32, 0xf8, 0xbd, 0x02, 0x9d,
+	0xad, 0xe3, 0xeb, 0xdb, 0x51, 0x3e, 0xf1, 0xee, 0x5b, 0xc7, 0x41, 0x32, 0x80, 0xa6, 0x2c, 0xc6,
+	0xd1, 0x1c, 0x57, 0xae, 0x38, 0x9f, 0x7e, 0x70, 0x52, 0xc3, 0x61, 0x31, 0x7e, 0x8a, 0x2b, 0xda,
+	0x90, 0xe6, 0x97, 0xfc, 0x17, 0x76, 0x96, 0x42, 0xe9, 0xa8, 0xa4, 0x78, 0x8d, 0x99, 0x3b, 0x6c,
+	0xdb, 0x72, 0x43, 0x4d, 0x05, 0x7d, 0x68, 0xd8, 0x4d, 0x7a, 0xe8, 0xaa, 0x95, 0x44, 0x77, 0xbd,
+	0xcc, 0x5a, 0x0f, 0xdd, 0x25, 0x4b, 0x0b, 0x2c, 0x47, 0xb7, 0x01, 0xc1, 0xcf, 0x15, 0x68, 0xd8,
+	0x5e, 0xd4, 0x0e, 0x19, 0xb2, 0xc9, 0xca, 0xbd, 0xdc, 0x16, 0x90, 0x87, 0xd0, 0x88, 0x67, 0x18,
+	0xcf, 0xf5, 0x4b, 0xad, 0x9f, 0x98, 0x3b, 0xd7, 0x36, 0x73, 0x78, 0xaa, 0xfd, 0xa8, 0x73, 0x0f,
+	0x1e, 0x43, 0xdd, 0x10, 0x3a, 0x18, 0xce, 0x16, 0xe5, 0xff, 0x9a, 0xb5, 0x9e, 0x83, 0x62, 0x6e,
+	0xc2, 0xf3, 0x69, 0x55, 0x98, 0x8f, 0xc2, 0x05, 0xe6, 0x39, 0x9b, 0x96, 0x75, 0x29, 0xe1, 0x31,
+	0x05, 0x32, 0xcc, 0x0a, 0x8e, 0xa6, 0x42, 0x39, 0xc5, 0xef, 0x0b, 0xcc, 0x95, 0xae, 0xd2, 0x79,
+	0x26, 0x16, 0x65, 0xe5, 0xed, 0xc4, 0x04, 0x4d, 0xb9, 0x92, 0x1f, 0x42, 0x4b, 0x89, 0xed, 0xc6,
+	0xf0, 0x95, 0xb0, 0xc6, 0xe3, 0xa7, 0xb0, 0x77, 0x86, 0x2c, 0x35, 0x1f, 0x3c, 0x4e, 0x70, 0xa3,
+	0x86, 0x95, 0xed, 0x1a, 0xfe, 0x07, 0x40, 0xea, 0x61, 0x97, 0x2b, 0xe4, 0x56, 0xca, 0xa7, 0x1b,
+	0x4c, 0xff, 0x6d, 0x05, 0x76, 0x9f, 0xd9, 0x6f, 0xf4, 0x11, 0x66, 0xcb, 0x24, 0x46, 0xf2, 0x39,
+	0x34, 0xdc, 0x55, 0xbe, 0x19, 0xda, 0x6f, 0xf5, 0xb0, 0xfc, 0x56, 0x0f, 0x1f, 0xeb, 0x6f, 0xf5,
+	0x80, 0x5c, 0xce, 0x22, 0xf9, 0x02, 0x9a, 0xee, 0xe9, 0xbe, 0x76, 0xdb, 0x8d, 0x2b, 0xde, 0x77,
+	0xf2, 0x15, 0xb4, 0x37, 0x32, 0x44, 0x6e, 0x6a, 0x9f, 0xcb, 0x29, 0x0b, 0xae, 0xd1, 0x24, 0x0f,
+	0xc1, 0x2f, 0x93, 0x41, 0x8c, 0xfe, 0x85, 0xd4, 0x5c, 0xb7, 0x71, 0xdc, 0x30, 0xf8, 0xb3, 0xbf,
+	0x07, 0x00, 0x61, 0xbb, 0x1b, 0x41, 0xa2, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // ManagerServiceClient is the client API for ManagerService service.
 //
@@ -1161,10 +1278,10 @@ type ManagerServiceClient interface {
 }
 
 type managerServiceClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewManagerServiceClient(cc *grpc.ClientConn) ManagerServiceClient {
+func NewManagerServiceClient(cc grpc.ClientConnInterface) ManagerServiceClient {
 	return &managerServiceClient{cc}
 }
 
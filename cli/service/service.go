@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/MinterTeam/minter-go-node/cli/pb"
 	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/health"
 	"github.com/MinterTeam/minter-go-node/core/minter"
 	"github.com/MinterTeam/minter-go-node/version"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -73,6 +74,16 @@ func (m *Manager) Status(context.Context, *empty.Empty) (*pb.StatusResponse, err
 		},
 	}
 
+	report := health.NewChecker(m.blockchain, m.tmRPC, m.cfg).Ready()
+	response.Health = &pb.StatusResponse_Health{Ready: report.Ok}
+	for _, check := range report.Checks {
+		response.Health.Checks = append(response.Health.Checks, &pb.StatusResponse_Health_Check{
+			Name:    check.Name,
+			Ok:      check.Ok,
+			Message: check.Message,
+		})
+	}
+
 	return response, nil
 }
 
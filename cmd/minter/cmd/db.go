@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/appdb"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/tree"
+	"github.com/spf13/cobra"
+	db "github.com/tendermint/tm-db"
+	"os"
+	"path/filepath"
+)
+
+// migrateBatchSize is a number of keys written to the target database at once
+const migrateBatchSize = 10000
+
+var DBCommand = &cobra.Command{
+	Use:   "db",
+	Short: "Manage databases of the stopped node",
+}
+
+var DBMigrate = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copy all databases to another db_backend and verify the app hash",
+	Long: `Copy all databases of the node to another backend. The node should be stopped.
+Original databases are kept with .bak suffix, db_backend in config should be changed after the migration.`,
+	RunE: dbMigrate,
+}
+
+// nodeDB is a database of the node which is migrated along with others
+type nodeDB struct {
+	name     string
+	dir      string
+	memLimit int
+}
+
+func (d nodeDB) path() string {
+	return filepath.Join(d.dir, d.name+".db")
+}
+
+func (d nodeDB) migrationName(backend db.BackendType) string {
+	return d.name + ".migrate-" + string(backend)
+}
+
+func dbMigrate(cmd *cobra.Command, args []string) error {
+	to, err := cmd.Flags().GetString("to")
+	if err != nil {
+		return err
+	}
+
+	from, target := db.BackendType(cfg.DBBackend), db.BackendType(to)
+	if from == target {
+		return fmt.Errorf("databases already use %s", from)
+	}
+
+	if from == db.MemDBBackend || target == db.MemDBBackend {
+		return errors.New("memdb is not persistent and can't be migrated")
+	}
+
+	dbs := nodeDBs(cfg)
+	for _, d := range dbs {
+		if err := migrateDB(d, from, target); err != nil {
+			return fmt.Errorf("can't migrate %s: %s", d.path(), err)
+		}
+	}
+
+	if err := verifyMigration(dbs[0], dbs[1], target); err != nil {
+		return err
+	}
+
+	for _, d := range dbs {
+		if err := os.Rename(d.path(), d.path()+"."+string(from)+".bak"); err != nil {
+			return err
+		}
+
+		if err := os.Rename(filepath.Join(d.dir, d.migrationName(target)+".db"), d.path()); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Databases are migrated to %s. Set db_backend = \"%s\" in %s before starting the node,\n", target, target, utils.GetMinterConfigPath())
+	fmt.Printf("original databases are kept with .%s.bak suffix.\n", from)
+
+	return nil
+}
+
+// nodeDBs returns existing databases of the node, state and app databases go first
+func nodeDBs(cfg *config.Config) []nodeDB {
+	dataDir := utils.GetMinterHome() + "/data"
+	tmDataDir := config.GetTmConfig(cfg).DBDir()
+
+	all := []nodeDB{
+		{name: "state", dir: dataDir, memLimit: cfg.StateMemAvailable},
+		{name: "app", dir: dataDir, memLimit: 1024},
+		{name: "events", dir: dataDir, memLimit: 1024},
+		{name: "payloads", dir: dataDir, memLimit: 1024},
+		{name: "webhooks", dir: dataDir, memLimit: 1024},
+		{name: "blockstore", dir: tmDataDir, memLimit: 1024},
+		{name: "state", dir: tmDataDir, memLimit: 1024},
+		{name: "tx_index", dir: tmDataDir, memLimit: 1024},
+		{name: "evidence", dir: tmDataDir, memLimit: 1024},
+	}
+	if cfg.StateArchiveDir != "" {
+		all = append(all, nodeDB{name: "archive", dir: cfg.StateArchiveDir, memLimit: 1024})
+	}
+
+	var dbs []nodeDB
+	for _, d := range all {
+		if _, err := os.Stat(d.path()); err == nil {
+			dbs = append(dbs, d)
+		}
+	}
+
+	return dbs
+}
+
+// migrateDB copies all keys of the database to the new database of the target backend placed next to the original one
+func migrateDB(d nodeDB, from, to db.BackendType) error {
+	source, err := minter.OpenDB(d.name, from, d.dir, d.memLimit)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	// leftovers of the failed migration
+	if err := os.RemoveAll(filepath.Join(d.dir, d.migrationName(to)+".db")); err != nil {
+		return err
+	}
+
+	target, err := minter.OpenDB(d.migrationName(to), to, d.dir, d.memLimit)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	it, err := source.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := target.NewBatch()
+	count := 0
+	for ; it.Valid(); it.Next() {
+		batch.Set(it.Key(), it.Value())
+		count++
+
+		if count%migrateBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = target.NewBatch()
+		}
+	}
+
+	err = batch.WriteSync()
+	batch.Close()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Copied %d keys of %s\n", count, d.path())
+
+	return nil
+}
+
+// verifyMigration checks that the migrated state has the same hash as the last app hash saved by the node
+func verifyMigration(stateDB, appDB nodeDB, backend db.BackendType) error {
+	if stateDB.name != "state" || appDB.name != "app" || stateDB.dir != appDB.dir {
+		return errors.New("state and app databases are not found")
+	}
+
+	ldb, err := minter.OpenDB(stateDB.migrationName(backend), backend, stateDB.dir, stateDB.memLimit)
+	if err != nil {
+		return err
+	}
+	defer ldb.Close()
+
+	adb, err := minter.OpenDB(appDB.migrationName(backend), backend, appDB.dir, appDB.memLimit)
+	if err != nil {
+		return err
+	}
+	application := appdb.NewAppDBFromDB(adb)
+	defer application.Close()
+
+	height := application.GetLastHeight()
+	if height == 0 {
+		return nil
+	}
+
+	iavlTree := tree.NewMutableTree(ldb, 1024)
+	if _, err := iavlTree.LazyLoadVersion(int64(height)); err != nil {
+		return fmt.Errorf("can't load migrated state at height %d: %s", height, err)
+	}
+
+	if hash := application.GetLastBlockHash(); !bytes.Equal(iavlTree.Hash(), hash) {
+		return fmt.Errorf("app hash mismatch at height %d: expected %X, got %X", height, hash, iavlTree.Hash())
+	}
+
+	fmt.Printf("App hash at height %d is %X\n", height, iavlTree.Hash())
+
+	return nil
+}
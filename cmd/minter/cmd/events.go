@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/core/appdb"
+	"github.com/MinterTeam/minter-go-node/core/events"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/spf13/cobra"
+	db "github.com/tendermint/tm-db"
+)
+
+var EventsCommand = &cobra.Command{
+	Use:   "events",
+	Short: "Manage events database of the stopped node",
+}
+
+var EventsPrune = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete events older than the given number of last heights",
+	RunE:  eventsPrune,
+}
+
+var EventsCompact = &cobra.Command{
+	Use:   "compact",
+	Short: "Compact events database to reclaim disk space freed by pruning",
+	RunE:  eventsCompact,
+}
+
+func eventsPrune(cmd *cobra.Command, args []string) error {
+	keep, err := cmd.Flags().GetInt64("keep")
+	if err != nil {
+		return err
+	}
+
+	if keep == 0 {
+		keep = cfg.KeepLastEvents
+	}
+
+	if keep <= 0 {
+		return errors.New("number of heights to keep is not set, use --keep or keep_last_events in config")
+	}
+
+	compact, err := cmd.Flags().GetBool("compact")
+	if err != nil {
+		return err
+	}
+
+	adb, err := minter.OpenDB("app", db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data", 1024)
+	if err != nil {
+		return err
+	}
+	applicationDB := appdb.NewAppDBFromDB(adb)
+	height := applicationDB.GetLastHeight()
+	applicationDB.Close()
+
+	if height <= uint64(keep) {
+		fmt.Printf("Nothing to prune at height %d\n", height)
+		return nil
+	}
+
+	edb, err := openEventsDB()
+	if err != nil {
+		return err
+	}
+	defer edb.Close()
+
+	pruner, err := events.NewPruner(edb)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := pruner.Prune(height-uint64(keep)+1, 0)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted events of %d heights, the oldest available height is %d\n", deleted, pruner.Pruned())
+
+	if compact {
+		return compactEvents(edb)
+	}
+
+	return nil
+}
+
+func eventsCompact(cmd *cobra.Command, args []string) error {
+	edb, err := openEventsDB()
+	if err != nil {
+		return err
+	}
+	defer edb.Close()
+
+	return compactEvents(edb)
+}
+
+func openEventsDB() (db.DB, error) {
+	return minter.OpenDB("events", db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data", 1024)
+}
+
+func compactEvents(edb db.DB) error {
+	fmt.Println("Compacting events database...")
+	if err := events.Compact(edb); err != nil {
+		return err
+	}
+	fmt.Println("Done")
+
+	return nil
+}
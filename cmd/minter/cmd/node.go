@@ -2,15 +2,20 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	api_v1 "github.com/MinterTeam/minter-go-node/api"
+	"github.com/MinterTeam/minter-go-node/api/graphql"
+	"github.com/MinterTeam/minter-go-node/api/limiter"
 	api_v2 "github.com/MinterTeam/minter-go-node/api/v2"
 	service_api "github.com/MinterTeam/minter-go-node/api/v2/service"
 	"github.com/MinterTeam/minter-go-node/cli/service"
 	"github.com/MinterTeam/minter-go-node/cmd/utils"
 	"github.com/MinterTeam/minter-go-node/config"
 	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/state"
 	"github.com/MinterTeam/minter-go-node/core/statistics"
+	"github.com/MinterTeam/minter-go-node/core/webhooks"
 	"github.com/MinterTeam/minter-go-node/log"
 	"github.com/MinterTeam/minter-go-node/version"
 	"github.com/spf13/cobra"
@@ -26,15 +31,21 @@ import (
 	rpc "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/store"
 	tmTypes "github.com/tendermint/tendermint/types"
+	db "github.com/tendermint/tm-db"
 	"io"
 	"net/http"
 	_ "net/http/pprof"
 	"net/url"
 	"os"
+	"os/signal"
 	"syscall"
+	"time"
 )
 
-const RequiredOpenFilesLimit = 10000
+const (
+	RequiredOpenFilesLimit  = 10000
+	RemoteSignerWaitTimeout = 10 * time.Second
+)
 
 var RunNode = &cobra.Command{
 	Use:   "node",
@@ -98,6 +109,18 @@ func runNode(cmd *cobra.Command) error {
 
 	app := minter.NewMinterBlockchain(cfg)
 
+	dispatcher, err := newWebhooksDispatcher(cfg, logger)
+	if err != nil {
+		return err
+	}
+	app.SetWebhooks(dispatcher)
+
+	archive, err := newStateArchive(cfg, logger)
+	if err != nil {
+		return err
+	}
+	app.SetStateArchive(archive)
+
 	// update BlocksTimeDelta in case it was corrupted
 	updateBlocksTimeDelta(app, tmConfig)
 
@@ -109,6 +132,9 @@ func runNode(cmd *cobra.Command) error {
 	app.SetTmNode(node)
 
 	if !cfg.ValidatorMode {
+		// limits are shared by API v1 and v2
+		apiLimiter := limiter.NewLimiter(cfg.APIGateway)
+
 		go func(srv *service_api.Service) {
 			grpcUrl, err := url.Parse(cfg.GRPCListenAddress)
 			if err != nil {
@@ -118,13 +144,22 @@ func runNode(cmd *cobra.Command) error {
 			if err != nil {
 				logger.Error("Failed to parse API v2 address", err)
 			}
-			logger.Error("Failed to start Api V2 in both gRPC and RESTful", api_v2.Run(srv, grpcUrl.Host, apiV2url.Host))
+			logger.Error("Failed to start Api V2 in both gRPC and RESTful", api_v2.Run(srv, grpcUrl.Host, apiV2url.Host, apiLimiter))
 		}(service_api.NewService(amino.NewCodec(), app, client, node, cfg, version.Version))
 
-		go api_v1.RunAPI(app, client, cfg, logger)
+		go api_v1.RunAPI(app, client, cfg, apiLimiter, logger)
+
+		if cfg.GraphQLListenAddress != "" {
+			go func() {
+				logger.Error("Failed to start GraphQL API", "err", graphql.Run(app, client, cfg, apiLimiter, logger))
+			}()
+		}
 	}
 
 	ctx, stop := context.WithCancel(context.Background())
+	go dispatcher.Run(ctx)
+	go archive.Run(ctx)
+
 	ctxCli, _ := context.WithCancel(ctx)
 	go func() {
 		err := service.StartCLIServer(utils.GetMinterHome()+"/manager.sock", service.NewManager(app, client, cfg), ctxCli)
@@ -149,6 +184,41 @@ func runNode(cmd *cobra.Command) error {
 	select {}
 }
 
+// newWebhooksDispatcher creates dispatcher of notifications about committed blocks, nil if no endpoints are configured
+func newWebhooksDispatcher(cfg *config.Config, logger tmlog.Logger) (*webhooks.Dispatcher, error) {
+	if cfg.Webhooks == nil || len(cfg.Webhooks.Endpoints) == 0 {
+		return nil, nil
+	}
+
+	queueDB := db.NewDB("webhooks", db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data")
+
+	deadLetter, err := os.OpenFile(utils.GetMinterHome()+"/data/webhooks_dead_letter.log", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks.NewDispatcher(cfg.Webhooks, queueDB, deadLetter, logger.With("module", "webhooks"))
+}
+
+// newStateArchive opens archive of old snapshots of the state, nil if it is not configured
+func newStateArchive(cfg *config.Config, logger tmlog.Logger) (*state.Archive, error) {
+	if cfg.StateArchiveDir == "" {
+		return nil, nil
+	}
+
+	if cfg.StateSnapshotInterval <= 0 {
+		return nil, errors.New("state_snapshot_interval should be set to use state_archive_dir")
+	}
+
+	if err := tmos.EnsureDir(cfg.StateArchiveDir, 0777); err != nil {
+		return nil, err
+	}
+
+	archiveDB := db.NewDB("archive", db.BackendType(cfg.DBBackend), cfg.StateArchiveDir)
+
+	return state.NewArchive(archiveDB, logger.With("module", "archive")), nil
+}
+
 func updateBlocksTimeDelta(app *minter.Blockchain, config *tmCfg.Config) {
 	blockStoreDB, err := tmNode.DefaultDBProvider(&tmNode.DBContext{ID: "blockstore", Config: config})
 	if err != nil {
@@ -174,9 +244,18 @@ func startTendermintNode(app types.Application, cfg *tmCfg.Config, logger tmlog.
 		panic(err)
 	}
 
+	privValidator, err := getPrivValidator(cfg, logger)
+	if err != nil {
+		logger.Error("failed to load private validator", "err", err)
+		os.Exit(1)
+	}
+
+	// remote signer client is already listening, Tendermint should not start another one
+	cfg.PrivValidatorListenAddr = ""
+
 	node, err := tmNode.NewNode(
 		cfg,
-		privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		getGenesis,
@@ -200,6 +279,54 @@ func startTendermintNode(app types.Application, cfg *tmCfg.Config, logger tmlog.
 	return node
 }
 
+// getPrivValidator returns file private validator or, if priv_validator_laddr is set,
+// a client of the external signer. The key file is not read or generated in the latter case.
+func getPrivValidator(cfg *tmCfg.Config, logger tmlog.Logger) (tmTypes.PrivValidator, error) {
+	if cfg.PrivValidatorListenAddr == "" {
+		return privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()), nil
+	}
+
+	listener, err := privval.NewSignerListener(cfg.PrivValidatorListenAddr, logger.With("module", "privval"))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := privval.NewSignerClient(listener)
+	if err != nil {
+		return nil, err
+	}
+
+	// node is not started yet, so interrupting is handled here to stop waiting for the signer
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(quit)
+
+	for {
+		logger.Info("Waiting for remote signer", "addr", cfg.PrivValidatorListenAddr)
+		err := client.WaitForConnection(RemoteSignerWaitTimeout)
+		if err == nil {
+			break
+		}
+
+		logger.Error("Failed to connect to remote signer", "addr", cfg.PrivValidatorListenAddr, "err", err)
+
+		select {
+		case <-quit:
+			_ = client.Close()
+			return nil, errors.New("interrupted while waiting for remote signer")
+		default:
+		}
+	}
+
+	if client.GetPubKey() == nil {
+		return nil, errors.New("could not retrieve public key from remote signer")
+	}
+
+	logger.Info("Connected to remote signer", "addr", cfg.PrivValidatorListenAddr)
+
+	return client, nil
+}
+
 func getGenesis() (doc *tmTypes.GenesisDoc, e error) {
 	genDocFile := utils.GetMinterHome() + "/config/genesis.json"
 	if _, err := os.Stat(genDocFile); os.IsNotExist(err) {
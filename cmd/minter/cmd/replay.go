@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/replay"
+	"github.com/spf13/cobra"
+	db "github.com/tendermint/tm-db"
+	"os"
+)
+
+var Replay = &cobra.Command{
+	Use:   "replay",
+	Short: "Re-execute stored blocks and print state changes of each transaction",
+	Long: `Re-execute blocks from the block store against a copy of the state kept at height from-1.
+Changes are kept in memory, databases of the node are not modified. The node should be stopped.
+Replay stops at the first block whose app hash differs from the one recorded by the next block.`,
+	RunE: replayBlocks,
+}
+
+func replayBlocks(cmd *cobra.Command, args []string) error {
+	from, err := cmd.Flags().GetUint64("from")
+	if err != nil {
+		return err
+	}
+
+	to, err := cmd.Flags().GetUint64("to")
+	if err != nil {
+		return err
+	}
+
+	if to < from {
+		return fmt.Errorf("to (%d) is less than from (%d)", to, from)
+	}
+
+	dbs, err := openReplayDBs(cfg)
+	if err != nil {
+		return err
+	}
+
+	replayer, err := replay.New(cfg, dbs, from, os.Stdout)
+	if err != nil {
+		return err
+	}
+	defer replayer.Close()
+
+	if err := replayer.Run(to); err != nil {
+		return err
+	}
+
+	fmt.Printf("Blocks %d-%d are replayed, app hashes match\n", from, replayer.Height())
+
+	return nil
+}
+
+// openReplayDBs opens databases of the node used by the replay
+func openReplayDBs(cfg *config.Config) (replay.Databases, error) {
+	backend := db.BackendType(cfg.DBBackend)
+	dataDir := utils.GetMinterHome() + "/data"
+	tmDataDir := config.GetTmConfig(cfg).DBDir()
+
+	var dbs replay.Databases
+	for _, d := range []struct {
+		target   *db.DB
+		name     string
+		dir      string
+		memLimit int
+	}{
+		{&dbs.State, "state", dataDir, cfg.StateMemAvailable},
+		{&dbs.App, "app", dataDir, 1024},
+		{&dbs.Events, "events", dataDir, 1024},
+		{&dbs.BlockStore, "blockstore", tmDataDir, 1024},
+		{&dbs.TmState, "state", tmDataDir, 1024},
+	} {
+		database, err := minter.OpenDB(d.name, backend, d.dir, d.memLimit)
+		if err != nil {
+			return dbs, fmt.Errorf("can't open %s database: %s", d.name, err)
+		}
+		*d.target = database
+	}
+
+	return dbs, nil
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/log"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/privval"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/ssh/terminal"
+	"io/ioutil"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	signerDialRetries   = 1000000
+	signerDialRetryWait = time.Second
+	signerReadTimeout   = 5 * time.Second
+
+	encryptedKeyScryptN = 1 << 15
+	encryptedKeyScryptR = 8
+	encryptedKeyScryptP = 1
+)
+
+var RunSigner = &cobra.Command{
+	Use:   "signer",
+	Short: "Run remote signer for the validator key",
+	Long: `Serves the validator key over Tendermint's remote signer protocol.
+The signer dials the node's priv_validator_laddr and keeps track of the last signed
+height, round and step in the state file to prevent double signing.`,
+	RunE: runSigner,
+}
+
+var EncryptValidatorKey = &cobra.Command{
+	Use:   "encrypt_key",
+	Short: "Encrypt validator key file with a password to be used by the signer",
+	RunE:  encryptValidatorKey,
+}
+
+// encryptedPVKey is a validator private key encrypted with a password derived key
+type encryptedPVKey struct {
+	PubKey     string `json:"pub_key"`
+	Salt       string `json:"salt"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+func runSigner(cmd *cobra.Command, args []string) error {
+	logger := log.NewLogger(cfg).With("module", "signer")
+
+	addr, err := cmd.Flags().GetString("addr")
+	if err != nil {
+		return err
+	}
+	if addr == "" {
+		return errors.New("addr of the node's priv_validator_laddr is required")
+	}
+
+	chainID, err := cmd.Flags().GetString("chain-id")
+	if err != nil {
+		return err
+	}
+	if chainID == "" {
+		return errors.New("chain-id is required")
+	}
+
+	pv, err := loadSignerPrivValidator(cmd)
+	if err != nil {
+		return err
+	}
+
+	var dialer privval.SocketDialer
+	protocol, address := tmnet.ProtocolAndAddress(addr)
+	switch protocol {
+	case "unix":
+		dialer = privval.DialUnixFn(address)
+	case "tcp":
+		dialer = privval.DialTCPFn(address, signerReadTimeout, ed25519.GenPrivKey())
+	default:
+		return fmt.Errorf("wrong address: expected either 'tcp' or 'unix' protocols, got %s", protocol)
+	}
+
+	endpoint := privval.NewSignerDialerEndpoint(logger, dialer)
+	privval.SignerDialerEndpointConnRetries(signerDialRetries)(endpoint)
+	privval.SignerDialerEndpointRetryWaitInterval(signerDialRetryWait)(endpoint)
+	privval.SignerDialerEndpointTimeoutReadWrite(signerReadTimeout)(endpoint)
+
+	server := privval.NewSignerServer(endpoint, chainID, pv)
+	if err := server.Start(); err != nil {
+		return err
+	}
+
+	logger.Info("Started signer", "addr", addr, "pub_key", fmt.Sprintf("Mp%x", pv.GetPubKey().Bytes()[5:]))
+
+	tmos.TrapSignal(logger, func() {
+		if err := server.Stop(); err != nil {
+			logger.Error("failed to stop signer", "err", err)
+		}
+	})
+
+	// Run forever
+	select {}
+}
+
+// loadSignerPrivValidator loads the file validator or decrypts the encrypted key. In both cases
+// the last sign state is persisted to the state file.
+func loadSignerPrivValidator(cmd *cobra.Command) (*privval.FilePV, error) {
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return nil, err
+	}
+
+	stateFile, err := cmd.Flags().GetString("state-file")
+	if err != nil {
+		return nil, err
+	}
+	if stateFile == "" {
+		stateFile = cfg.PrivValidatorStateFile()
+	}
+
+	encryptedKeyFile, err := cmd.Flags().GetString("encrypted-key-file")
+	if err != nil {
+		return nil, err
+	}
+
+	if encryptedKeyFile == "" {
+		if keyFile == "" {
+			keyFile = cfg.PrivValidatorKeyFile()
+		}
+		if !tmos.FileExists(keyFile) {
+			return nil, fmt.Errorf("private validator file %s does not exist", keyFile)
+		}
+		if !tmos.FileExists(stateFile) {
+			pv := privval.LoadFilePVEmptyState(keyFile, stateFile)
+			pv.LastSignState.Save()
+			return pv, nil
+		}
+
+		return privval.LoadFilePV(keyFile, stateFile), nil
+	}
+
+	password, err := readPassword(cmd, "Enter password: ")
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := ioutil.ReadFile(encryptedKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := decryptPVKey(enc, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// key path is left empty, so the decrypted key is never written to disk
+	pv := privval.GenFilePV("", stateFile)
+	pv.Key.PrivKey = privKey
+	pv.Key.PubKey = privKey.PubKey()
+	pv.Key.Address = privKey.PubKey().Address()
+
+	if !tmos.FileExists(stateFile) {
+		pv.LastSignState.Save()
+		return pv, nil
+	}
+
+	stateJSON, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cdc := amino.NewCodec()
+	cryptoAmino.RegisterAmino(cdc)
+
+	var state privval.FilePVLastSignState
+	if err := cdc.UnmarshalJSON(stateJSON, &state); err != nil {
+		return nil, fmt.Errorf("error reading private validator state from %s: %s", stateFile, err)
+	}
+
+	pv.LastSignState.Height = state.Height
+	pv.LastSignState.Round = state.Round
+	pv.LastSignState.Step = state.Step
+	pv.LastSignState.Signature = state.Signature
+	pv.LastSignState.SignBytes = state.SignBytes
+
+	return pv, nil
+}
+
+func encryptValidatorKey(cmd *cobra.Command, args []string) error {
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return err
+	}
+	if keyFile == "" {
+		keyFile = cfg.PrivValidatorKeyFile()
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		return errors.New("output file is required")
+	}
+
+	if !tmos.FileExists(keyFile) {
+		return fmt.Errorf("private validator file %s does not exist", keyFile)
+	}
+
+	if tmos.FileExists(output) {
+		return fmt.Errorf("file %s already exists", output)
+	}
+
+	password, err := readPassword(cmd, "Enter new password: ")
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Flags().Changed("password-file") {
+		confirmation, err := readPassword(cmd, "Repeat password: ")
+		if err != nil {
+			return err
+		}
+		if password != confirmation {
+			return errors.New("passwords do not match")
+		}
+	}
+
+	pv := privval.LoadFilePVEmptyState(keyFile, "")
+	enc, err := encryptPVKey(pv.Key.PrivKey.Bytes(), password)
+	if err != nil {
+		return err
+	}
+
+	enc.PubKey = fmt.Sprintf("Mp%x", pv.GetPubKey().Bytes()[5:])
+
+	data, err := json.MarshalIndent(enc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := tmos.WriteFile(output, data, 0600); err != nil {
+		return err
+	}
+
+	fmt.Printf("Encrypted key of %s saved to %s\n", enc.PubKey, output)
+	fmt.Printf("Now you can remove %s from the node\n", keyFile)
+
+	return nil
+}
+
+func encryptPVKey(privKey []byte, password string) (*encryptedPVKey, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	secret, err := scrypt.Key([]byte(password), salt, encryptedKeyScryptN, encryptedKeyScryptR, encryptedKeyScryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptedPVKey{
+		Salt:       hex.EncodeToString(salt),
+		Ciphertext: hex.EncodeToString(xsalsa20symmetric.EncryptSymmetric(privKey, secret)),
+	}, nil
+}
+
+func decryptPVKey(data []byte, password string) (ed25519.PrivKeyEd25519, error) {
+	var enc encryptedPVKey
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return ed25519.PrivKeyEd25519{}, err
+	}
+
+	salt, err := hex.DecodeString(enc.Salt)
+	if err != nil {
+		return ed25519.PrivKeyEd25519{}, err
+	}
+
+	ciphertext, err := hex.DecodeString(enc.Ciphertext)
+	if err != nil {
+		return ed25519.PrivKeyEd25519{}, err
+	}
+
+	secret, err := scrypt.Key([]byte(password), salt, encryptedKeyScryptN, encryptedKeyScryptR, encryptedKeyScryptP, 32)
+	if err != nil {
+		return ed25519.PrivKeyEd25519{}, err
+	}
+
+	plaintext, err := xsalsa20symmetric.DecryptSymmetric(ciphertext, secret)
+	if err != nil {
+		return ed25519.PrivKeyEd25519{}, errors.New("wrong password or corrupted key file")
+	}
+
+	privKey, err := cryptoAmino.PrivKeyFromBytes(plaintext)
+	if err != nil {
+		return ed25519.PrivKeyEd25519{}, err
+	}
+
+	edKey, ok := privKey.(ed25519.PrivKeyEd25519)
+	if !ok {
+		return ed25519.PrivKeyEd25519{}, errors.New("only ed25519 validator keys are supported")
+	}
+
+	return edKey, nil
+}
+
+func readPassword(cmd *cobra.Command, prompt string) (string, error) {
+	passwordFile, err := cmd.Flags().GetString("password-file")
+	if err != nil {
+		return "", err
+	}
+
+	if passwordFile != "" {
+		data, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	fmt.Print(prompt)
+	password, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+
+	if len(password) == 0 {
+		return "", errors.New("password should not be empty")
+	}
+
+	return string(password), nil
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func encryptTestKey(t *testing.T, privKey ed25519.PrivKeyEd25519, password string) []byte {
+	enc, err := encryptPVKey(privKey.Bytes(), password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestEncryptPVKey(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	data := encryptTestKey(t, privKey, "password")
+
+	decrypted, err := decryptPVKey(data, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !decrypted.Equals(privKey) {
+		t.Fatalf("Decrypted key differs from the original one")
+	}
+}
+
+func TestDecryptPVKeyWithWrongPassword(t *testing.T) {
+	data := encryptTestKey(t, ed25519.GenPrivKey(), "password")
+
+	if _, err := decryptPVKey(data, "wrong password"); err == nil {
+		t.Fatalf("Key should not be decrypted with wrong password")
+	}
+}
+
+func TestDecryptTamperedPVKey(t *testing.T) {
+	data := encryptTestKey(t, ed25519.GenPrivKey(), "password")
+
+	var enc encryptedPVKey
+	if err := json.Unmarshal(data, &enc); err != nil {
+		t.Fatal(err)
+	}
+
+	ciphertext, err := hex.DecodeString(enc.Ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext[len(ciphertext)-1] ^= 1
+	enc.Ciphertext = hex.EncodeToString(ciphertext)
+
+	tampered, err := json.Marshal(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := decryptPVKey(tampered, "password"); err == nil {
+		t.Fatalf("Tampered key should be rejected")
+	}
+}
+
+func TestLoadSignerPrivValidator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	privKey := ed25519.GenPrivKey()
+	keyFile := filepath.Join(dir, "encrypted_key.json")
+	passwordFile := filepath.Join(dir, "password")
+	stateFile := filepath.Join(dir, "state.json")
+
+	if err := ioutil.WriteFile(keyFile, encryptTestKey(t, privKey, "password"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	load := func(password string) error {
+		if err := ioutil.WriteFile(passwordFile, []byte(password+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		command := &cobra.Command{}
+		command.Flags().String("key-file", "", "")
+		command.Flags().String("state-file", stateFile, "")
+		command.Flags().String("encrypted-key-file", keyFile, "")
+		command.Flags().String("password-file", passwordFile, "")
+
+		pv, err := loadSignerPrivValidator(command)
+		if err != nil {
+			return err
+		}
+
+		if !pv.GetPubKey().Equals(privKey.PubKey()) {
+			t.Fatalf("Wrong public key of loaded validator")
+		}
+
+		return nil
+	}
+
+	if err := load("wrong password"); err == nil {
+		t.Fatalf("Validator should not be loaded with wrong password")
+	}
+
+	if err := load("password"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(stateFile); err != nil {
+		t.Fatalf("Sign state should be saved: %s", err)
+	}
+
+	// existing sign state is loaded
+	if err := load("password"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/spf13/cobra"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+var TxCommand = &cobra.Command{
+	Use:   "tx",
+	Short: "Build and sign transactions offline",
+	// transactions are built without the node, so its config is not required
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+}
+
+var TxBuild = &cobra.Command{
+	Use:   "build [description.json]",
+	Short: "Encode unsigned transaction from JSON description",
+	Long: `Reads JSON description of a transaction from the file or from stdin and prints unsigned
+transaction, the hash to sign, gas and commission in base coin. Data of the transaction has
+the same format as decoded data returned by API, nonce is required.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: buildTx,
+}
+
+var TxSign = &cobra.Command{
+	Use:   "sign [tx]",
+	Short: "Sign transaction with a private key from the key file",
+	Long: `Signs hex encoded transaction given as argument or read from stdin and prints signed transaction.
+Signatures of multi-signature transactions are appended to the existing ones.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: signTx,
+}
+
+type builtTx struct {
+	Tx         string `json:"tx"`
+	Hash       string `json:"hash"`
+	Gas        int64  `json:"gas"`
+	Commission string `json:"commission"`
+}
+
+func buildTx(cmd *cobra.Command, args []string) error {
+	var input []byte
+	var err error
+	if len(args) > 0 {
+		input, err = ioutil.ReadFile(args[0])
+	} else {
+		input, err = readStdin()
+	}
+	if err != nil {
+		return err
+	}
+
+	description := new(transaction.TxDescription)
+	if err := json.Unmarshal(input, description); err != nil {
+		return fmt.Errorf("cannot parse transaction description: %s", err)
+	}
+
+	if description.Nonce == 0 {
+		return errors.New("nonce is required")
+	}
+
+	tx, err := description.Build()
+	if err != nil {
+		return err
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return err
+	}
+
+	result, err := json.MarshalIndent(builtTx{
+		Tx:         fmt.Sprintf("0x%x", encodedTx),
+		Hash:       fmt.Sprintf("0x%x", tx.Hash().Bytes()),
+		Gas:        tx.Gas(),
+		Commission: tx.CommissionInBaseCoin().String(),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(result))
+
+	return nil
+}
+
+func signTx(cmd *cobra.Command, args []string) error {
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return err
+	}
+	if keyFile == "" {
+		return errors.New("key-file is required")
+	}
+
+	privateKey, err := crypto.LoadECDSA(keyFile)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+
+	var input []byte
+	if len(args) > 0 {
+		input = []byte(args[0])
+	} else if input, err = readStdin(); err != nil {
+		return err
+	}
+
+	encodedTx, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(input)), "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid tx: %s", err)
+	}
+
+	tx, err := transaction.TxDecoder.DecodeFromBytesWithoutSig(encodedTx)
+	if err != nil {
+		return fmt.Errorf("cannot decode transaction: %s", err)
+	}
+
+	// keep multisig address and signatures of other owners
+	if tx.SignatureType == transaction.SigTypeMulti && len(tx.SignatureData) > 0 {
+		if tx, err = transaction.DecodeSig(tx); err != nil {
+			return fmt.Errorf("cannot decode multi-signature: %s", err)
+		}
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		return err
+	}
+
+	signedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("0x%x\n", signedTx)
+
+	return nil
+}
+
+func readStdin() ([]byte, error) {
+	return ioutil.ReadAll(io.LimitReader(os.Stdin, 1<<20))
+}
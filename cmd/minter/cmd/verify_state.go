@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/appdb"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/store"
+	db "github.com/tendermint/tm-db"
+	"os"
+	"path/filepath"
+)
+
+var VerifyState = &cobra.Command{
+	Use:   "verify-state",
+	Short: "Audit the whole state at the height and print discrepancies as JSON",
+	Long: `Iterate all accounts, stakes, frozen funds and coins of the state and check coin volumes, reserves,
+total stakes of candidates and the root hash against app hashes saved by the node. The node should be stopped.`,
+	RunE: verifyState,
+}
+
+func verifyState(cmd *cobra.Command, args []string) error {
+	height, err := cmd.Flags().GetUint64("height")
+	if err != nil {
+		return err
+	}
+
+	backend := db.BackendType(cfg.DBBackend)
+	dataDir := utils.GetMinterHome() + "/data"
+
+	adb, err := minter.OpenDB("app", backend, dataDir, 1024)
+	if err != nil {
+		return err
+	}
+	applicationDB := appdb.NewAppDBFromDB(adb)
+	defer applicationDB.Close()
+
+	lastHeight := applicationDB.GetLastHeight()
+	if height == 0 {
+		height = lastHeight
+	}
+
+	ldb, err := minter.OpenDB("state", backend, dataDir, cfg.StateMemAvailable)
+	if err != nil {
+		return err
+	}
+	defer ldb.Close()
+
+	report, err := state.Audit(height, ldb)
+	if err != nil {
+		return fmt.Errorf("can't load state at height %d: %s", height, err)
+	}
+
+	if height == lastHeight {
+		report.CheckHash("app_db", applicationDB.GetLastBlockHash())
+	}
+
+	if err := checkBlockAppHash(cfg, backend, report); err != nil {
+		return err
+	}
+
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(encoded))
+
+	if !report.Ok() {
+		return fmt.Errorf("found %d discrepancies in the state at height %d", len(report.Discrepancies), height)
+	}
+
+	return nil
+}
+
+// checkBlockAppHash compares the root hash with the app hash of the next block, which commits to the state at the height
+func checkBlockAppHash(cfg *config.Config, backend db.BackendType, report *state.AuditReport) error {
+	tmDataDir := config.GetTmConfig(cfg).DBDir()
+	if _, err := os.Stat(filepath.Join(tmDataDir, "blockstore.db")); err != nil {
+		return nil
+	}
+
+	bdb, err := minter.OpenDB("blockstore", backend, tmDataDir, 1024)
+	if err != nil {
+		return err
+	}
+	defer bdb.Close()
+
+	if meta := store.NewBlockStore(bdb).LoadBlockMeta(int64(report.Height) + 1); meta != nil {
+		report.CheckHash(fmt.Sprintf("block_%d", meta.Header.Height), meta.Header.AppHash)
+	}
+
+	return nil
+}
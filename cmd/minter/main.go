@@ -15,13 +15,42 @@ func main() {
 		cmd.ManagerCommand,
 		cmd.ManagerConsole,
 		cmd.VerifyGenesis,
+		cmd.VerifyState,
+		cmd.RunSigner,
+		cmd.TxCommand,
+		cmd.DBCommand,
+		cmd.Replay,
+		cmd.EventsCommand,
 		cmd.Version)
 
+	cmd.RunSigner.AddCommand(cmd.EncryptValidatorKey)
+	cmd.TxCommand.AddCommand(cmd.TxBuild, cmd.TxSign)
+	cmd.DBCommand.AddCommand(cmd.DBMigrate)
+	cmd.EventsCommand.AddCommand(cmd.EventsPrune, cmd.EventsCompact)
+
 	rootCmd.PersistentFlags().StringVar(&utils.MinterHome, "home-dir", "", "base dir (default is $HOME/.minter)")
 	rootCmd.PersistentFlags().StringVar(&utils.MinterConfig, "config", "", "path to config (default is $(home-dir)/config/config.toml)")
 	rootCmd.PersistentFlags().Bool("pprof", false, "enable pprof")
 	rootCmd.PersistentFlags().String("pprof-addr", "0.0.0.0:6060", "pprof listen addr")
 
+	cmd.RunSigner.Flags().String("addr", "", "priv_validator_laddr of the node to connect to, e.g. tcp://127.0.0.1:26659")
+	cmd.RunSigner.Flags().String("chain-id", "", "chain ID of the network")
+	cmd.RunSigner.PersistentFlags().String("key-file", "", "path to validator key file (default is priv_validator_key_file from config)")
+	cmd.RunSigner.Flags().String("state-file", "", "path to validator last sign state file (default is priv_validator_state_file from config)")
+	cmd.RunSigner.Flags().String("encrypted-key-file", "", "path to encrypted validator key file, used instead of key-file")
+	cmd.RunSigner.PersistentFlags().String("password-file", "", "read password of encrypted key from file instead of terminal")
+	cmd.EncryptValidatorKey.Flags().String("output", "", "path to save encrypted validator key")
+	cmd.TxSign.Flags().String("key-file", "", "path to file with hex encoded private key")
+	cmd.DBMigrate.Flags().String("to", "", "target db backend: goleveldb, cleveldb, boltdb or rocksdb (available backends depend on build tags)")
+	_ = cmd.DBMigrate.MarkFlagRequired("to")
+	cmd.EventsPrune.Flags().Int64("keep", 0, "number of last heights whose events are kept (default is keep_last_events from config)")
+	cmd.EventsPrune.Flags().Bool("compact", false, "compact events database after pruning")
+	cmd.VerifyState.Flags().Uint64("height", 0, "height of the state to verify (default is the last height)")
+	cmd.Replay.Flags().Uint64("from", 0, "first block to replay, the state at the previous height should be kept")
+	cmd.Replay.Flags().Uint64("to", 0, "last block to replay")
+	_ = cmd.Replay.MarkFlagRequired("from")
+	_ = cmd.Replay.MarkFlagRequired("to")
+
 	if err := rootCmd.Execute(); err != nil {
 		panic(err)
 	}
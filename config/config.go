@@ -107,6 +107,8 @@ type Config struct {
 	Consensus       *tmConfig.ConsensusConfig       `mapstructure:"consensus"`
 	TxIndex         *tmConfig.TxIndexConfig         `mapstructure:"tx_index"`
 	Instrumentation *tmConfig.InstrumentationConfig `mapstructure:"instrumentation"`
+	APIGateway      *APIGatewayConfig               `mapstructure:"api_gateway"`
+	Webhooks        *WebhooksConfig                 `mapstructure:"webhooks"`
 }
 
 // DefaultConfig returns a default configuration for a Tendermint node
@@ -119,6 +121,8 @@ func defaultConfig() *Config {
 		Consensus:       tmConfig.DefaultConsensusConfig(),
 		TxIndex:         tmConfig.DefaultTxIndexConfig(),
 		Instrumentation: tmConfig.DefaultInstrumentationConfig(),
+		APIGateway:      DefaultAPIGatewayConfig(),
+		Webhooks:        DefaultWebhooksConfig(),
 	}
 }
 
@@ -233,21 +237,155 @@ type BaseConfig struct {
 	// Address to listen for API v2 connections
 	APIv2ListenAddress string `mapstructure:"api_v2_listen_addr"`
 
+	// Address to listen for GraphQL API connections, empty - disabled
+	GraphQLListenAddress string `mapstructure:"graphql_listen_addr"`
+
+	// Maximum estimated cost of a GraphQL query, 0 - unlimited
+	GraphQLMaxCost int `mapstructure:"graphql_max_cost"`
+
 	ValidatorMode bool `mapstructure:"validator_mode"`
 
 	KeepLastStates int64 `mapstructure:"keep_last_states"`
 
+	// Every state at height divisible by this number is kept in addition to the last states, 0 - disabled
+	StateSnapshotInterval int64 `mapstructure:"state_snapshot_interval"`
+
+	// Directory of a separate database where old snapshots are moved to, empty - snapshots stay in the state database
+	StateArchiveDir string `mapstructure:"state_archive_dir"`
+
+	// Number of last heights whose events are kept, older events are pruned on commit, 0 - events are kept forever
+	KeepLastEvents int64 `mapstructure:"keep_last_events"`
+
 	APISimultaneousRequests int `mapstructure:"api_simultaneous_requests"`
 
+	// Number of API responses to requests with explicit height kept in cache, 0 - disabled
+	APICacheSize int `mapstructure:"api_cache_size"`
+
+	// Memory in MB taken by cached API responses, approximated by their encoded size
+	APICacheMem int `mapstructure:"api_cache_mem"`
+
 	LogPath string `mapstructure:"log_path"`
 
 	StateCacheSize int `mapstructure:"state_cache_size"`
 
 	StateMemAvailable int `mapstructure:"state_mem_available"`
 
+	// Number of historical states kept in memory for API requests, 0 - disabled
+	StateViewsPoolSize int `mapstructure:"state_views_pool_size"`
+
+	// Index payloads and service data of transactions for API search
+	PayloadIndex bool `mapstructure:"payload_index"`
+
+	// Coins whose reserve and volume are exported as Prometheus metrics
+	MetricsCoins []string `mapstructure:"metrics_coins"`
+
+	// Node is not ready if its last block is older, 0 - not checked
+	HealthMaxBlockAge time.Duration `mapstructure:"health_max_block_age"`
+
+	// Node is not ready if it has fewer peers
+	HealthMinPeers int `mapstructure:"health_min_peers"`
+
 	HaltHeight int `mapstructure:"halt_height"`
 }
 
+// APIGatewayConfig defines access rules and rate limits of API v1 and v2
+type APIGatewayConfig struct {
+	// If false, all requests are served without limits
+	Enabled bool `mapstructure:"enabled"`
+
+	// If true, requests without a valid API key are rejected
+	RequireKey bool `mapstructure:"require_key"`
+
+	// Requests per second allowed for a single IP address without API key, 0 - unlimited
+	IPRateLimit float64 `mapstructure:"ip_rate_limit"`
+
+	// Maximum burst of requests from a single IP address without API key
+	IPRateBurst int `mapstructure:"ip_rate_burst"`
+
+	// Header set by a trusted reverse proxy with the address of a client, e.g. X-Real-IP
+	RealIPHeader string `mapstructure:"real_ip_header"`
+
+	// Routes allowed to be called, empty - all routes
+	AllowRoutes []string `mapstructure:"allow_routes"`
+
+	// Routes not allowed to be called
+	DenyRoutes []string `mapstructure:"deny_routes"`
+
+	// Number of tokens consumed by a request to the route, default is 1
+	RouteCosts map[string]int `mapstructure:"route_costs"`
+
+	Keys []APIKeyConfig `mapstructure:"keys"`
+}
+
+// APIKeyConfig defines limits of a single API key
+type APIKeyConfig struct {
+	Key string `mapstructure:"key"`
+
+	// Requests per second allowed for the key, 0 - unlimited
+	RateLimit float64 `mapstructure:"rate_limit"`
+
+	// Maximum burst of requests for the key
+	RateBurst int `mapstructure:"rate_burst"`
+}
+
+// DefaultAPIGatewayConfig returns disabled API gateway with sane limits for a public node
+func DefaultAPIGatewayConfig() *APIGatewayConfig {
+	return &APIGatewayConfig{
+		Enabled:     false,
+		RequireKey:  false,
+		IPRateLimit: 10,
+		IPRateBurst: 50,
+		AllowRoutes: []string{},
+		DenyRoutes:  []string{},
+		RouteCosts: map[string]int{
+			"candidates":   20,
+			"transactions": 5,
+			"rewards":      20,
+			"apr":          5,
+		},
+		Keys: []APIKeyConfig{},
+	}
+}
+
+// WebhooksConfig defines HTTP endpoints notified about transactions and events of committed blocks
+type WebhooksConfig struct {
+	// Number of delivery attempts before a notification is written to the dead letter log
+	MaxAttempts int `mapstructure:"max_attempts"`
+
+	// Timeout of a single delivery attempt
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	Endpoints []WebhookConfig `mapstructure:"endpoints"`
+}
+
+// WebhookConfig defines an endpoint and filters of notifications sent to it.
+// Empty filter matches everything.
+type WebhookConfig struct {
+	URL string `mapstructure:"url"`
+
+	// Key of HMAC-SHA256 signature of payloads, the signature is sent in X-Minter-Signature header
+	Secret string `mapstructure:"secret"`
+
+	// Kinds of notifications: tx, reward, slash, unbond
+	Kinds []string `mapstructure:"kinds"`
+
+	// Types of transactions, e.g. 1 for send
+	TxTypes []int `mapstructure:"tx_types"`
+
+	Addresses  []string `mapstructure:"addresses"`
+	Coins      []string `mapstructure:"coins"`
+	Candidates []string `mapstructure:"candidates"`
+}
+
+// DefaultWebhooksConfig returns config without endpoints
+func DefaultWebhooksConfig() *WebhooksConfig {
+	return &WebhooksConfig{
+		MaxAttempts: 10,
+		Timeout:     10 * time.Second,
+		Endpoints:   []WebhookConfig{},
+	}
+}
+
 // DefaultBaseConfig returns a default base configuration for a Tendermint node
 func DefaultBaseConfig() BaseConfig {
 	return BaseConfig{
@@ -265,11 +403,23 @@ func DefaultBaseConfig() BaseConfig {
 		APIListenAddress:        "tcp://0.0.0.0:8841",
 		GRPCListenAddress:       "tcp://0.0.0.0:8842",
 		APIv2ListenAddress:      "tcp://0.0.0.0:8843",
+		GraphQLListenAddress:    "",
+		GraphQLMaxCost:          10000,
 		ValidatorMode:           false,
 		KeepLastStates:          120,
+		StateSnapshotInterval:   0,
+		KeepLastEvents:          0,
+		StateArchiveDir:         "",
 		StateCacheSize:          1000000,
 		StateMemAvailable:       1024,
+		StateViewsPoolSize:      10,
 		APISimultaneousRequests: 100,
+		APICacheSize:            10000,
+		APICacheMem:             256,
+		PayloadIndex:            false,
+		MetricsCoins:            []string{},
+		HealthMaxBlockAge:       time.Minute,
+		HealthMinPeers:          1,
 		LogPath:                 "stdout",
 		LogFormat:               LogFormatPlain,
 	}
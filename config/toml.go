@@ -75,27 +75,69 @@ grpc_listen_addr = "{{ .BaseConfig.GRPCListenAddress }}"
 # Address to listen for API V2 connections
 api_v2_listen_addr = "{{ .BaseConfig.APIv2ListenAddress }}"
 
+# Address to listen for GraphQL API connections, empty - disabled
+graphql_listen_addr = "{{ .BaseConfig.GraphQLListenAddress }}"
+
+# Maximum estimated cost of a GraphQL query, each resolved value costs 1, 0 - unlimited
+graphql_max_cost = {{ .BaseConfig.GraphQLMaxCost }}
+
 # Sets node to be in validator mode. Disables API, events, history of blocks, indexes, etc. 
 validator_mode = {{ .BaseConfig.ValidatorMode }}
 
 # Sets number of last stated to be saved
 keep_last_states = {{ .BaseConfig.KeepLastStates }}
 
+# Keeps forever every state at height divisible by this number, e.g. for historical balances, 0 - disabled
+state_snapshot_interval = {{ .BaseConfig.StateSnapshotInterval }}
+
+# Directory of a separate database where snapshots older than keep_last_states are moved to,
+# empty - snapshots stay in the state database. Each archived snapshot is a full copy of the state,
+# so the archive grows by the size of the whole state every state_snapshot_interval blocks
+state_archive_dir = "{{ .BaseConfig.StateArchiveDir }}"
+
+# Sets number of last heights whose events are kept, older events are pruned on commit, 0 - keep all events.
+# Use "minter events prune" and "minter events compact" to prune existing events and reclaim disk space
+keep_last_events = {{ .BaseConfig.KeepLastEvents }}
+
 # State cache size 
 state_cache_size = {{ .BaseConfig.StateCacheSize }}
 
 # State memory in MB
 state_mem_available = {{ .BaseConfig.StateMemAvailable }}
 
+# Number of historical states kept in memory to serve API requests with explicit height, 0 - disabled
+state_views_pool_size = {{ .BaseConfig.StateViewsPoolSize }}
+
 # Limit for simultaneous requests to API
 api_simultaneous_requests = {{ .BaseConfig.APISimultaneousRequests }}
 
+# Number of API responses to requests with explicit height kept in memory, 0 - disabled
+api_cache_size = {{ .BaseConfig.APICacheSize }}
+
+# Memory in MB taken by cached API responses, approximated by their encoded size. Larger responses are not cached
+api_cache_mem = {{ .BaseConfig.APICacheMem }}
+
+# Index payloads and service data of transactions to search them by exact value or prefix via API.
+# Only transactions of blocks committed after the index is enabled are indexed
+payload_index = {{ .BaseConfig.PayloadIndex }}
+
+# Coins whose reserve and volume are exported as Prometheus metrics when prometheus is enabled, e.g. ["COIN"]
+metrics_coins = [{{ range $i, $v := .BaseConfig.MetricsCoins }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# Readiness check served under /health/ready of API fails if the last block is older than this, 0 - not checked
+health_max_block_age = "{{ .BaseConfig.HealthMaxBlockAge }}"
+
+# Readiness check fails if the node has fewer peers
+health_min_peers = {{ .BaseConfig.HealthMinPeers }}
+
 # If this node is many blocks behind the tip of the chain, FastSync
 # allows them to catchup quickly by downloading blocks in parallel
 # and verifying their commits
 fast_sync = {{ .BaseConfig.FastSync }}
 
-# Database backend: leveldb | memdb
+# Database backend: goleveldb | cleveldb | boltdb | rocksdb | memdb
+# cleveldb, boltdb and rocksdb require the node built with the same build tag,
+# existing databases can be converted with "minter db migrate --to <backend>"
 db_backend = "{{ .BaseConfig.DBBackend }}"
 
 # Database directory
@@ -116,6 +158,11 @@ log_path = "{{ .BaseConfig.LogPath }}"
 priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
+# TCP or UNIX socket address for Tendermint to listen on for
+# connections from an external PrivValidator process (see "minter signer").
+# If set, priv_validator_key_file is not used by the node
+priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey}}"
 
@@ -236,4 +283,76 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "minter"
-`
+
+##### API access configuration options #####
+[api_gateway]
+
+# When true, API keys, rate limits and route lists below are enforced
+# for API v1 and API v2
+enabled = {{ .APIGateway.Enabled }}
+
+# Reject requests without a valid API key. Key is passed in X-API-Key header
+# or, for API v1, in api_key query parameter
+require_key = {{ .APIGateway.RequireKey }}
+
+# Requests per second allowed for a single IP address without API key, 0 - unlimited
+ip_rate_limit = {{ .APIGateway.IPRateLimit }}
+
+# Maximum burst of requests from a single IP address without API key
+ip_rate_burst = {{ .APIGateway.IPRateBurst }}
+
+# Header set by a trusted reverse proxy with the address of a client, e.g. "X-Real-IP".
+# Leave empty if API is not behind a proxy
+real_ip_header = "{{ .APIGateway.RealIPHeader }}"
+
+# Routes allowed to be called, e.g. ["status", "address"]. Empty list allows all routes.
+# API v2 methods are named as API v1 routes, e.g. "estimate_coin_sell"
+allow_routes = [{{ range $i, $route := .APIGateway.AllowRoutes }}{{ if $i }}, {{ end }}"{{ $route }}"{{ end }}]
+
+# Routes not allowed to be called
+deny_routes = [{{ range $i, $route := .APIGateway.DenyRoutes }}{{ if $i }}, {{ end }}"{{ $route }}"{{ end }}]
+
+# Number of tokens consumed by a request to the route, default is 1
+[api_gateway.route_costs]
+{{ range $route, $cost := .APIGateway.RouteCosts }}{{ $route }} = {{ $cost }}
+{{ end }}
+# API keys with own limits, e.g.
+# [[api_gateway.keys]]
+# key = "secret"
+# rate_limit = 100
+# rate_burst = 500
+{{ range .APIGateway.Keys }}
+[[api_gateway.keys]]
+key = "{{ .Key }}"
+rate_limit = {{ .RateLimit }}
+rate_burst = {{ .RateBurst }}
+{{ end }}
+##### webhooks configuration options #####
+[webhooks]
+
+# Number of delivery attempts of a notification before it is written
+# to data/webhooks_dead_letter.log
+max_attempts = {{ .Webhooks.MaxAttempts }}
+
+# Timeout of a single delivery attempt
+timeout = "{{ .Webhooks.Timeout }}"
+
+# Endpoints notified with signed JSON payloads after each committed block, e.g.
+# [[webhooks.endpoints]]
+# url = "https://example.com/minter"
+# secret = "secret"
+# kinds = ["tx", "reward", "slash", "unbond"]
+# tx_types = [1, 13]
+# addresses = ["Mx..."]
+# coins = ["BIP"]
+# candidates = ["Mp..."]
+{{ range .Webhooks.Endpoints }}
+[[webhooks.endpoints]]
+url = "{{ .URL }}"
+secret = "{{ .Secret }}"
+kinds = [{{ range $i, $v := .Kinds }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+tx_types = [{{ range $i, $v := .TxTypes }}{{ if $i }}, {{ end }}{{ $v }}{{ end }}]
+addresses = [{{ range $i, $v := .Addresses }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+coins = [{{ range $i, $v := .Coins }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+candidates = [{{ range $i, $v := .Candidates }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+{{ end }}`
@@ -160,7 +160,12 @@ func (appDB *AppDB) SetLastBlocksTimeDelta(height uint64, delta int) {
 }
 
 func NewAppDB(cfg *config.Config) *AppDB {
+	return NewAppDBFromDB(db.NewDB(dbName, db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data"))
+}
+
+// NewAppDBFromDB wraps already opened database, it is used by tools working with databases of a stopped node
+func NewAppDBFromDB(database db.DB) *AppDB {
 	return &AppDB{
-		db: db.NewDB(dbName, db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data"),
+		db: database,
 	}
 }
@@ -0,0 +1,221 @@
+// Package cache implements LRU cache of API responses to requests pinned to a height.
+// Such responses never change until the state of the height is pruned.
+package cache
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"math"
+	"strings"
+	"sync"
+)
+
+var (
+	hits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "api_cache_hits",
+		Help: "Number of API responses served from cache",
+	})
+	misses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "api_cache_misses",
+		Help: "Number of cacheable API requests not found in cache",
+	})
+	evictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "api_cache_evictions",
+		Help: "Number of API responses evicted from cache by size limit or pruning",
+	})
+	entries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "api_cache_entries",
+		Help: "Number of API responses in cache",
+	})
+	cachedBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "api_cache_bytes",
+		Help: "Approximate size of API responses in cache",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(hits, misses, evictions, entries, cachedBytes)
+}
+
+type entry struct {
+	key    string
+	height uint64
+	value  interface{}
+	bytes  int64
+}
+
+// ResponseCache is a LRU cache limited by number of entries and their approximate size in bytes.
+// Nil cache is valid and caches nothing.
+type ResponseCache struct {
+	lock     sync.Mutex
+	size     int
+	maxBytes int64
+	bytes    int64
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+// NewResponseCache creates cache for size responses taking up to maxBytes, responses larger than maxBytes
+// are not cached. Returns nil if size or maxBytes is not positive.
+func NewResponseCache(size int, maxBytes int64) *ResponseCache {
+	if size <= 0 || maxBytes <= 0 {
+		return nil
+	}
+
+	return &ResponseCache{
+		size:     size,
+		maxBytes: maxBytes,
+		items:    make(map[string]*list.Element, size),
+		order:    list.New(),
+	}
+}
+
+// Key builds cache key of the route and its params
+func Key(route string, params ...interface{}) string {
+	parts := make([]string, 0, len(params)+1)
+	parts = append(parts, route)
+	for _, param := range params {
+		parts = append(parts, fmt.Sprintf("%v", param))
+	}
+
+	return strings.Join(parts, "|")
+}
+
+func (c *ResponseCache) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	item, ok := c.items[key]
+	if !ok {
+		misses.Inc()
+		return nil, false
+	}
+
+	hits.Inc()
+	c.order.MoveToFront(item)
+	return item.Value.(*entry).value, true
+}
+
+// Set stores response to the request pinned to height
+func (c *ResponseCache) Set(key string, height uint64, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	bytes := int64(len(key)) + approximateSize(value)
+	if bytes > c.maxBytes {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if item, ok := c.items[key]; ok {
+		e := item.Value.(*entry)
+		c.bytes += bytes - e.bytes
+		e.value, e.bytes = value, bytes
+		c.order.MoveToFront(item)
+	} else {
+		c.items[key] = c.order.PushFront(&entry{key: key, height: height, value: value, bytes: bytes})
+		c.bytes += bytes
+	}
+
+	for c.order.Len() > c.size || c.bytes > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+
+	c.updateMetrics()
+}
+
+// GetOrLoad returns cached response or loads and caches it. Errors are not cached.
+func (c *ResponseCache) GetOrLoad(key string, height uint64, load func() (interface{}, error)) (interface{}, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Set(key, height, value)
+	return value, nil
+}
+
+// Prune drops responses to requests pinned to heights up to the given one, their states are deleted.
+// Responses of heights kept by the predicate, e.g. snapshots, stay in cache.
+func (c *ResponseCache) Prune(height uint64, keep func(height uint64) bool) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for item := c.order.Back(); item != nil; {
+		prev := item.Prev()
+		if h := item.Value.(*entry).height; h <= height && (keep == nil || !keep(h)) {
+			c.remove(item)
+		}
+		item = prev
+	}
+
+	c.updateMetrics()
+}
+
+func (c *ResponseCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.order.Len()
+}
+
+// Bytes returns approximate size of cached responses
+func (c *ResponseCache) Bytes() int64 {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.bytes
+}
+
+func (c *ResponseCache) remove(item *list.Element) {
+	c.order.Remove(item)
+	delete(c.items, item.Value.(*entry).key)
+	c.bytes -= item.Value.(*entry).bytes
+	evictions.Inc()
+}
+
+func (c *ResponseCache) updateMetrics() {
+	entries.Set(float64(c.order.Len()))
+	cachedBytes.Set(float64(c.bytes))
+}
+
+// approximateSize returns encoded size of the response: protobuf size of API v2 responses
+// and JSON size of API v1 ones. Responses which can't be encoded are not cached.
+func approximateSize(value interface{}) int64 {
+	if message, ok := value.(proto.Message); ok {
+		return int64(proto.Size(message))
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return math.MaxInt64
+	}
+
+	return int64(len(data))
+}
@@ -0,0 +1,151 @@
+package cache
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResponseCacheEviction(t *testing.T) {
+	c := NewResponseCache(2, 1000)
+
+	c.Set("a", 1, "a")
+	c.Set("b", 1, "b")
+
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("Entry a should be cached")
+	}
+
+	c.Set("c", 1, "c")
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("Least recently used entry b should be evicted")
+	}
+
+	if value, ok := c.Get("a"); !ok || value != "a" {
+		t.Fatalf("Entry a should be cached, got %v", value)
+	}
+
+	if c.Len() != 2 {
+		t.Fatalf("Cache should contain 2 entries, got %d", c.Len())
+	}
+}
+
+func TestResponseCacheBytesLimit(t *testing.T) {
+	c := NewResponseCache(10, 20)
+
+	// key and JSON encoded value take 6 bytes
+	c.Set("a", 1, "abc")
+	c.Set("b", 1, "abc")
+	c.Set("c", 1, "abc")
+
+	if c.Bytes() != 18 {
+		t.Fatalf("Cache should take 18 bytes, got %d", c.Bytes())
+	}
+
+	c.Set("d", 1, "abc")
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Least recently used entry a should be evicted by size")
+	}
+
+	if c.Len() != 3 || c.Bytes() != 18 {
+		t.Fatalf("Cache should contain 3 entries of 18 bytes, got %d entries of %d bytes", c.Len(), c.Bytes())
+	}
+
+	c.Set("large", 1, strings.Repeat("a", 20))
+
+	if _, ok := c.Get("large"); ok {
+		t.Fatalf("Response larger than the limit should not be cached")
+	}
+
+	if c.Len() != 3 {
+		t.Fatalf("Entries should not be evicted by response which is not cached")
+	}
+}
+
+func TestResponseCachePrune(t *testing.T) {
+	c := NewResponseCache(10, 1000)
+
+	c.Set("a", 1, "a")
+	c.Set("b", 2, "b")
+	c.Set("c", 3, "c")
+
+	c.Prune(2, nil)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Entry at pruned height should be removed")
+	}
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("Entry at pruned height should be removed")
+	}
+
+	if _, ok := c.Get("c"); !ok {
+		t.Fatalf("Entry above pruned height should be kept")
+	}
+}
+
+func TestResponseCachePruneKeepsSnapshots(t *testing.T) {
+	c := NewResponseCache(10, 1000)
+
+	c.Set("a", 1, "a")
+	c.Set("b", 2, "b")
+
+	c.Prune(2, func(height uint64) bool {
+		return height == 2
+	})
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Entry at pruned height should be removed")
+	}
+
+	if _, ok := c.Get("b"); !ok {
+		t.Fatalf("Entry at kept height should stay in cache")
+	}
+}
+
+func TestResponseCacheGetOrLoad(t *testing.T) {
+	c := NewResponseCache(10, 1000)
+
+	calls := 0
+	load := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := c.GetOrLoad("key", 1, load)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if value != 1 {
+			t.Fatalf("Cached value should be returned, got %v", value)
+		}
+	}
+
+	if _, err := c.GetOrLoad("error", 1, func() (interface{}, error) {
+		return nil, errors.New("not found")
+	}); err == nil {
+		t.Fatalf("Error should be returned")
+	}
+
+	if _, ok := c.Get("error"); ok {
+		t.Fatalf("Errors should not be cached")
+	}
+}
+
+func TestDisabledResponseCache(t *testing.T) {
+	c := NewResponseCache(0, 1000)
+
+	c.Set("a", 1, "a")
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Disabled cache should not keep entries")
+	}
+
+	c.Prune(1, nil)
+	if c.Len() != 0 {
+		t.Fatalf("Disabled cache should be empty")
+	}
+}
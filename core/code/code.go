@@ -40,6 +40,11 @@ const (
 	IncorrectPubKey       uint32 = 407
 	StakeShouldBePositive uint32 = 408
 	TooLowStake           uint32 = 409
+	TooLowDelegation      uint32 = 410
+	StakeCoinNotAllowed   uint32 = 411
+	NotWhitelisted        uint32 = 412
+	TooLargeWhitelist     uint32 = 413
+	PublicKeyInBlockList  uint32 = 414
 
 	// check
 	CheckInvalidLock uint32 = 501
@@ -12,6 +12,9 @@ const (
 	PayloadByte           int64 = 2
 	ToggleCandidateStatus int64 = 100
 	EditCandidate         int64 = 10000
+	EditCommission        int64 = 10000
+	EditPublicKey         int64 = 100000
+	SetDelegationPolicy   int64 = 10000
 	MultisendDelta        int64 = 5
 	RedeemCheckTx         int64 = SendTx * 3
 )
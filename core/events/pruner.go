@@ -0,0 +1,122 @@
+// Package events queries and prunes events of blocks stored by events-db. Events of a block are stored
+// under 4 bytes big endian height, other keys are dictionaries of addresses and public keys
+// shared by all blocks, they are never pruned.
+package events
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	db "github.com/tendermint/tm-db"
+	"math"
+	"sync"
+)
+
+const heightKeyLength = 4
+
+// prunedKey holds the lowest height whose events are kept, its length differs from keys of heights
+var prunedKey = []byte("prunedHeight")
+
+var ErrCompactionUnsupported = errors.New("compaction is supported only by goleveldb backend")
+
+// Pruner deletes events of heights below the given one and remembers the lowest kept height
+type Pruner struct {
+	db db.DB
+
+	lock   sync.RWMutex
+	pruned uint64
+}
+
+func NewPruner(db db.DB) (*Pruner, error) {
+	value, err := db.Get(prunedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Pruner{db: db}
+	if len(value) == 8 {
+		p.pruned = binary.BigEndian.Uint64(value)
+	}
+
+	return p, nil
+}
+
+// Pruned returns the lowest height whose events are kept, events of all heights below it are deleted
+func (p *Pruner) Pruned() uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.pruned
+}
+
+// IsPruned reports whether events of the height are deleted
+func (p *Pruner) IsPruned(height uint64) bool {
+	return height < p.Pruned()
+}
+
+// Prune deletes events of heights below the given one, at most limit heights are visited if limit is positive.
+// It returns a number of deleted heights.
+func (p *Pruner) Prune(below uint64, limit int) (int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if below > math.MaxUint32 {
+		below = math.MaxUint32
+	}
+
+	if below <= p.pruned {
+		return 0, nil
+	}
+
+	it, err := p.db.Iterator(heightKey(p.pruned), heightKey(below))
+	if err != nil {
+		return 0, err
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	deleted, reached := 0, below
+	for ; it.Valid(); it.Next() {
+		if len(it.Key()) != heightKeyLength {
+			continue
+		}
+
+		if limit > 0 && deleted == limit {
+			reached = uint64(binary.BigEndian.Uint32(it.Key()))
+			break
+		}
+
+		batch.Delete(append([]byte{}, it.Key()...))
+		deleted++
+	}
+	it.Close()
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, reached)
+	batch.Set(prunedKey, value)
+
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	p.pruned = reached
+
+	return deleted, nil
+}
+
+// Compact reclaims disk space freed by pruning
+func Compact(database db.DB) error {
+	ldb, ok := database.(*db.GoLevelDB)
+	if !ok {
+		return ErrCompactionUnsupported
+	}
+
+	return ldb.DB().CompactRange(util.Range{})
+}
+
+func heightKey(height uint64) []byte {
+	key := make([]byte, heightKeyLength)
+	binary.BigEndian.PutUint32(key, uint32(height))
+
+	return key
+}
@@ -0,0 +1,83 @@
+package events
+
+import (
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	db "github.com/tendermint/tm-db"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestPrune(t *testing.T) {
+	database := db.NewMemDB()
+	store := eventsdb.NewEventsStore(database)
+	for height := uint32(1); height <= 10; height++ {
+		store.AddEvent(height, eventsdb.RewardEvent{
+			Role:            "Validator",
+			Address:         types.Address{1},
+			Amount:          "10",
+			ValidatorPubKey: types.Pubkey{2},
+		})
+		if err := store.CommitEvents(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pruner, err := NewPruner(database)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deleted, err := pruner.Prune(5, 2); err != nil || deleted != 2 || pruner.Pruned() != 3 {
+		t.Fatalf("Expected 2 heights pruned up to 3, got %d, %d, %v", deleted, pruner.Pruned(), err)
+	}
+
+	if deleted, err := pruner.Prune(5, 0); err != nil || deleted != 2 || pruner.Pruned() != 5 {
+		t.Fatalf("Expected 2 more heights pruned up to 5, got %d, %d, %v", deleted, pruner.Pruned(), err)
+	}
+
+	if !pruner.IsPruned(4) || pruner.IsPruned(5) {
+		t.Fatal("Heights below 5 should be pruned")
+	}
+
+	if events := store.LoadEvents(4); len(events) != 0 {
+		t.Fatalf("Events of pruned height are kept: %+v", events)
+	}
+
+	events := store.LoadEvents(5)
+	if len(events) != 1 || events[0].(*eventsdb.RewardEvent).Address != (types.Address{1}) {
+		t.Fatalf("Wrong events of kept height: %+v", events)
+	}
+
+	reopened, err := NewPruner(database)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reopened.Pruned() != 5 {
+		t.Fatalf("Pruned height is not persisted, got %d", reopened.Pruned())
+	}
+}
+
+func TestCompact(t *testing.T) {
+	if err := Compact(db.NewMemDB()); err != ErrCompactionUnsupported {
+		t.Fatalf("Expected ErrCompactionUnsupported, got %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ldb, err := db.NewGoLevelDB("events", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+
+	if err := Compact(ldb); err != nil {
+		t.Fatal(err)
+	}
+}
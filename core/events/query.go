@@ -0,0 +1,452 @@
+package events
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+const (
+	// MaxRange limits the number of blocks scanned by a single query
+	MaxRange = 100000
+
+	// MaxPerPage limits the number of events returned by a single query
+	MaxPerPage = 1000
+
+	// MaxGroups limits the number of groups returned by a single query, groups with the largest amounts are returned
+	MaxGroups = 1000
+)
+
+// Types of events
+const (
+	TypeReward = "reward"
+	TypeSlash  = "slash"
+	TypeUnbond = "unbond"
+)
+
+// Fields events can be grouped by. Groups are always split by coin, amounts of different coins can't be summed up.
+const (
+	FieldType      = "type"
+	FieldAddress   = "address"
+	FieldValidator = "validator"
+	FieldCoin      = "coin"
+	FieldRole      = "role"
+)
+
+// rewardRoles are roles of reward events known by events-db
+var rewardRoles = []string{
+	eventsdb.RoleValidator.String(),
+	eventsdb.RoleDelegator.String(),
+	eventsdb.RoleDAO.String(),
+	eventsdb.RoleDevelopers.String(),
+}
+
+// Event is a typed event of the block. Rewards are paid in base coin and only they have roles.
+type Event struct {
+	Height          uint64
+	Type            string
+	Address         types.Address
+	ValidatorPubKey types.Pubkey
+	Coin            types.CoinSymbol
+	Role            string
+	Amount          *big.Int
+}
+
+// NewEvent converts event of events-db, nil is returned for unknown events
+func NewEvent(height uint64, e eventsdb.Event) *Event {
+	var event *Event
+	var amount string
+	switch e := e.(type) {
+	case *eventsdb.RewardEvent:
+		event = &Event{Type: TypeReward, Address: e.Address, ValidatorPubKey: e.ValidatorPubKey, Coin: types.GetBaseCoin(), Role: e.Role}
+		amount = e.Amount
+	case *eventsdb.SlashEvent:
+		event = &Event{Type: TypeSlash, Address: e.Address, ValidatorPubKey: e.ValidatorPubKey, Coin: e.Coin}
+		amount = e.Amount
+	case *eventsdb.UnbondEvent:
+		event = &Event{Type: TypeUnbond, Address: e.Address, ValidatorPubKey: e.ValidatorPubKey, Coin: e.Coin}
+		amount = e.Amount
+	default:
+		return nil
+	}
+
+	event.Height = height
+	event.Amount, _ = big.NewInt(0).SetString(amount, 10)
+	if event.Amount == nil {
+		event.Amount = big.NewInt(0)
+	}
+
+	return event
+}
+
+// Filter selects events, empty lists match any value
+type Filter struct {
+	Types      []string
+	Addresses  []types.Address
+	Validators []types.Pubkey
+	Coins      []types.CoinSymbol
+	Roles      []string
+}
+
+// ParseFilter parses values of the filter given by API, addresses are Mx and public keys are Mp prefixed
+func ParseFilter(eventTypes, addresses, validators, coins, roles []string) (Filter, error) {
+	var filter Filter
+
+	for _, t := range nonEmpty(eventTypes) {
+		if t != TypeReward && t != TypeSlash && t != TypeUnbond {
+			return filter, fmt.Errorf("unknown event type %q, should be %s, %s or %s", t, TypeReward, TypeSlash, TypeUnbond)
+		}
+		filter.Types = append(filter.Types, t)
+	}
+
+	for _, address := range nonEmpty(addresses) {
+		decoded, err := decodePrefixed(address, "Mx", types.AddressLength)
+		if err != nil {
+			return filter, fmt.Errorf("invalid address %s: %s", address, err)
+		}
+		filter.Addresses = append(filter.Addresses, types.BytesToAddress(decoded))
+	}
+
+	for _, pubkey := range nonEmpty(validators) {
+		decoded, err := decodePrefixed(pubkey, "Mp", types.PubKeyLength)
+		if err != nil {
+			return filter, fmt.Errorf("invalid public key %s: %s", pubkey, err)
+		}
+		filter.Validators = append(filter.Validators, types.BytesToPubkey(decoded))
+	}
+
+	for _, coin := range nonEmpty(coins) {
+		filter.Coins = append(filter.Coins, types.StrToCoinSymbol(coin))
+	}
+
+	for _, role := range nonEmpty(roles) {
+		if !containsString(rewardRoles, role) {
+			return filter, fmt.Errorf("unknown role %q, should be one of %s", role, strings.Join(rewardRoles, ", "))
+		}
+		filter.Roles = append(filter.Roles, role)
+	}
+
+	return filter, nil
+}
+
+// Match reports whether the event satisfies the filter
+func (f Filter) Match(event *Event) bool {
+	if len(f.Types) != 0 && !containsString(f.Types, event.Type) {
+		return false
+	}
+
+	if len(f.Addresses) != 0 {
+		found := false
+		for _, address := range f.Addresses {
+			if address == event.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Validators) != 0 {
+		found := false
+		for _, pubkey := range f.Validators {
+			if pubkey == event.ValidatorPubKey {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Coins) != 0 {
+		found := false
+		for _, coin := range f.Coins {
+			if coin == event.Coin {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Roles) != 0 && !containsString(f.Roles, event.Role) {
+		return false
+	}
+
+	return true
+}
+
+// Query selects events of heights between FromHeight and ToHeight inclusive. Events of the page are returned
+// in order of heights, GroupBy fields enable aggregation of all matched events.
+type Query struct {
+	Filter
+	FromHeight uint64
+	ToHeight   uint64
+	GroupBy    []string
+	Offset     int
+	Limit      int
+}
+
+// SetRange sets heights of the query. Zero to means the last height, zero from means the to height.
+func (q *Query) SetRange(from, to, last uint64) error {
+	if to == 0 || to > last {
+		to = last
+	}
+
+	if from == 0 {
+		from = to
+	}
+
+	if from > to {
+		return errors.New("from height is greater than to height")
+	}
+
+	if to-from >= MaxRange {
+		return fmt.Errorf("range is too big, max %d blocks allowed", MaxRange)
+	}
+
+	q.FromHeight, q.ToHeight = from, to
+
+	return nil
+}
+
+// SetPage sets bounds of the page of events, page numbers start from 1
+func (q *Query) SetPage(page, perPage int) {
+	if page <= 0 {
+		page = 1
+	}
+
+	if perPage <= 0 || perPage > MaxPerPage {
+		perPage = MaxPerPage
+	}
+
+	q.Offset, q.Limit = (page-1)*perPage, perPage
+}
+
+// Group is a sum of amounts of matched events with equal values of grouped fields, other fields are empty
+type Group struct {
+	Type            string
+	Address         *types.Address
+	ValidatorPubKey *types.Pubkey
+	Coin            types.CoinSymbol
+	Role            string
+	Count           int
+	Amount          *big.Int
+}
+
+// Result of the query. Total is a number of all matched events, Groups are empty if GroupBy is not set.
+// TotalGroups is a number of all groups, only MaxGroups of them with the largest amounts are kept in Groups.
+type Result struct {
+	Events      []*Event
+	Total       int
+	Groups      []*Group
+	TotalGroups int
+}
+
+// ParseGroupBy validates names of fields events are grouped by
+func ParseGroupBy(fields []string) ([]string, error) {
+	var result []string
+	for _, field := range nonEmpty(fields) {
+		switch field {
+		case FieldType, FieldAddress, FieldValidator, FieldCoin, FieldRole:
+			result = append(result, field)
+		default:
+			return nil, fmt.Errorf("unknown field %q, should be one of %s", field,
+				strings.Join([]string{FieldType, FieldAddress, FieldValidator, FieldCoin, FieldRole}, ", "))
+		}
+	}
+
+	return result, nil
+}
+
+// Find executes the query over events of the store. It checks the context between heights.
+func Find(ctx context.Context, store eventsdb.IEventsDB, q Query) (*Result, error) {
+	result := &Result{Events: []*Event{}, Groups: []*Group{}}
+	groups := map[string]*Group{}
+
+	for height := q.FromHeight; height <= q.ToHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, e := range store.LoadEvents(uint32(height)) {
+			event := NewEvent(height, e)
+			if event == nil || !q.Match(event) {
+				continue
+			}
+
+			if result.Total >= q.Offset && (q.Limit <= 0 || len(result.Events) < q.Limit) {
+				result.Events = append(result.Events, event)
+			}
+			result.Total++
+
+			if len(q.GroupBy) != 0 {
+				key, group := q.group(event)
+				if existing, ok := groups[key]; ok {
+					group = existing
+				} else {
+					groups[key] = group
+					result.Groups = append(result.Groups, group)
+				}
+
+				group.Count++
+				group.Amount.Add(group.Amount, event.Amount)
+			}
+		}
+	}
+
+	sort.SliceStable(result.Groups, func(i, j int) bool {
+		return result.Groups[i].Amount.Cmp(result.Groups[j].Amount) == 1
+	})
+
+	result.TotalGroups = len(result.Groups)
+	if len(result.Groups) > MaxGroups {
+		result.Groups = result.Groups[:MaxGroups]
+	}
+
+	return result, nil
+}
+
+// group returns key of the group of the event and a new empty group for the key
+func (q Query) group(event *Event) (string, *Group) {
+	group := &Group{Coin: event.Coin, Amount: big.NewInt(0)}
+	key := event.Coin.String()
+
+	for _, field := range q.GroupBy {
+		switch field {
+		case FieldType:
+			group.Type = event.Type
+			key += "/" + event.Type
+		case FieldAddress:
+			address := event.Address
+			group.Address = &address
+			key += "/" + address.String()
+		case FieldValidator:
+			pubkey := event.ValidatorPubKey
+			group.ValidatorPubKey = &pubkey
+			key += "/" + pubkey.String()
+		case FieldRole:
+			group.Role = event.Role
+			key += "/" + event.Role
+		}
+	}
+
+	return key, group
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nonEmpty drops empty values, e.g. of unset query parameters
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func decodePrefixed(value string, prefix string, length int) ([]byte, error) {
+	if len(value) != len(prefix)+length*2 || value[:len(prefix)] != prefix {
+		return nil, fmt.Errorf("should be %d bytes with %s prefix", length, prefix)
+	}
+
+	return hex.DecodeString(value[len(prefix):])
+}
+
+// Response is a result of the query returned by API. TotalGroups is a number of all groups,
+// up to MaxGroups of them are returned.
+type Response struct {
+	FromHeight  uint64           `json:"from_height"`
+	ToHeight    uint64           `json:"to_height"`
+	Total       int              `json:"total"`
+	Events      []*ResponseEvent `json:"events"`
+	Groups      []*ResponseGroup `json:"groups,omitempty"`
+	TotalGroups int              `json:"total_groups,omitempty"`
+}
+
+// ResponseEvent is an event of the response, addresses and public keys are prefixed, amounts are in pips
+type ResponseEvent struct {
+	Height          uint64 `json:"height"`
+	Type            string `json:"type"`
+	Address         string `json:"address"`
+	ValidatorPubKey string `json:"validator_pub_key"`
+	Coin            string `json:"coin"`
+	Role            string `json:"role,omitempty"`
+	Amount          string `json:"amount"`
+}
+
+// ResponseGroup is a group of the response, fields which are not grouped by are omitted
+type ResponseGroup struct {
+	Type            string `json:"type,omitempty"`
+	Address         string `json:"address,omitempty"`
+	ValidatorPubKey string `json:"validator_pub_key,omitempty"`
+	Coin            string `json:"coin"`
+	Role            string `json:"role,omitempty"`
+	Count           int    `json:"count"`
+	Amount          string `json:"amount"`
+}
+
+// NewResponse converts result of the query to response of API
+func NewResponse(q Query, result *Result) *Response {
+	response := &Response{
+		FromHeight:  q.FromHeight,
+		ToHeight:    q.ToHeight,
+		Total:       result.Total,
+		Events:      make([]*ResponseEvent, 0, len(result.Events)),
+		TotalGroups: result.TotalGroups,
+	}
+
+	for _, event := range result.Events {
+		response.Events = append(response.Events, &ResponseEvent{
+			Height:          event.Height,
+			Type:            event.Type,
+			Address:         event.Address.String(),
+			ValidatorPubKey: event.ValidatorPubKey.String(),
+			Coin:            event.Coin.String(),
+			Role:            event.Role,
+			Amount:          event.Amount.String(),
+		})
+	}
+
+	for _, group := range result.Groups {
+		item := &ResponseGroup{
+			Type:   group.Type,
+			Coin:   group.Coin.String(),
+			Role:   group.Role,
+			Count:  group.Count,
+			Amount: group.Amount.String(),
+		}
+		if group.Address != nil {
+			item.Address = group.Address.String()
+		}
+		if group.ValidatorPubKey != nil {
+			item.ValidatorPubKey = group.ValidatorPubKey.String()
+		}
+
+		response.Groups = append(response.Groups, item)
+	}
+
+	return response
+}
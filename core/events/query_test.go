@@ -0,0 +1,191 @@
+package events
+
+import (
+	"context"
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	db "github.com/tendermint/tm-db"
+	"testing"
+)
+
+func newTestStore(t *testing.T) eventsdb.IEventsDB {
+	store := eventsdb.NewEventsStore(db.NewMemDB())
+	for height := uint32(1); height <= 4; height++ {
+		store.AddEvent(height, eventsdb.RewardEvent{
+			Role:            "Validator",
+			Address:         types.Address{1},
+			Amount:          "10",
+			ValidatorPubKey: types.Pubkey{1},
+		})
+		store.AddEvent(height, eventsdb.RewardEvent{
+			Role:            "Delegator",
+			Address:         types.Address{2},
+			Amount:          "5",
+			ValidatorPubKey: types.Pubkey{1},
+		})
+		if height%2 == 0 {
+			store.AddEvent(height, eventsdb.SlashEvent{
+				Address:         types.Address{2},
+				Amount:          "3",
+				Coin:            types.StrToCoinSymbol("TEST"),
+				ValidatorPubKey: types.Pubkey{2},
+			})
+		}
+		if err := store.CommitEvents(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return store
+}
+
+func TestFind(t *testing.T) {
+	store := newTestStore(t)
+
+	address := types.Address{2}
+	filter, err := ParseFilter(nil, []string{address.String()}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := Query{Filter: filter, FromHeight: 1, ToHeight: 4}
+	q.SetPage(2, 3)
+
+	result, err := Find(context.Background(), store, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 6 {
+		t.Fatalf("Expected 6 events, got %d", result.Total)
+	}
+
+	if len(result.Events) != 3 {
+		t.Fatalf("Expected 3 events of the page, got %d", len(result.Events))
+	}
+
+	if event := result.Events[0]; event.Height != 3 || event.Type != TypeReward || event.Role != "Delegator" {
+		t.Fatalf("Wrong first event of the page: %+v", event)
+	}
+
+	if event := result.Events[2]; event.Height != 4 || event.Type != TypeSlash || event.Coin != types.StrToCoinSymbol("TEST") || event.Amount.Int64() != 3 {
+		t.Fatalf("Wrong last event of the page: %+v", event)
+	}
+}
+
+func TestFindGroups(t *testing.T) {
+	store := newTestStore(t)
+
+	filter, err := ParseFilter([]string{TypeReward, TypeSlash}, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groupBy, err := ParseGroupBy([]string{FieldType, FieldAddress})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := Query{Filter: filter, FromHeight: 1, ToHeight: 4, GroupBy: groupBy}
+	q.SetPage(0, 1)
+
+	result, err := Find(context.Background(), store, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Events) != 1 || result.Total != 10 {
+		t.Fatalf("Expected 1 of 10 events, got %d of %d", len(result.Events), result.Total)
+	}
+
+	if len(result.Groups) != 3 || result.TotalGroups != 3 {
+		t.Fatalf("Expected 3 groups, got %d of %d", len(result.Groups), result.TotalGroups)
+	}
+
+	response := NewResponse(q, result)
+	expected := []ResponseGroup{
+		{Type: TypeReward, Address: types.Address{1}.String(), Coin: types.GetBaseCoin().String(), Count: 4, Amount: "40"},
+		{Type: TypeReward, Address: types.Address{2}.String(), Coin: types.GetBaseCoin().String(), Count: 4, Amount: "20"},
+		{Type: TypeSlash, Address: types.Address{2}.String(), Coin: "TEST", Count: 2, Amount: "6"},
+	}
+	for i, group := range response.Groups {
+		if *group != expected[i] {
+			t.Fatalf("Wrong group %d, expected %+v, got %+v", i, expected[i], *group)
+		}
+	}
+}
+
+func TestFindGroupsLimit(t *testing.T) {
+	store := eventsdb.NewEventsStore(db.NewMemDB())
+	for i := 0; i < MaxGroups+1; i++ {
+		var address types.Address
+		address[0], address[1] = byte(i>>8), byte(i)
+		store.AddEvent(1, eventsdb.RewardEvent{Role: "Delegator", Address: address, Amount: "1", ValidatorPubKey: types.Pubkey{1}})
+	}
+	store.AddEvent(1, eventsdb.RewardEvent{Role: "Delegator", Address: types.Address{0xff}, Amount: "10", ValidatorPubKey: types.Pubkey{1}})
+	if err := store.CommitEvents(); err != nil {
+		t.Fatal(err)
+	}
+
+	q := Query{FromHeight: 1, ToHeight: 1, GroupBy: []string{FieldAddress}}
+	q.SetPage(0, 1)
+
+	result, err := Find(context.Background(), store, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Groups) != MaxGroups || result.TotalGroups != MaxGroups+2 {
+		t.Fatalf("Expected %d of %d groups, got %d of %d", MaxGroups, MaxGroups+2, len(result.Groups), result.TotalGroups)
+	}
+
+	if *result.Groups[0].Address != (types.Address{0xff}) {
+		t.Fatalf("Group with the largest amount should be kept")
+	}
+}
+
+func TestFindCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Find(ctx, newTestStore(t), Query{FromHeight: 1, ToHeight: 4}); err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	if _, err := ParseFilter([]string{"transfer"}, nil, nil, nil, nil); err == nil {
+		t.Fatal("Expected error for unknown type")
+	}
+
+	if _, err := ParseFilter(nil, []string{"Mx01"}, nil, nil, nil); err == nil {
+		t.Fatal("Expected error for invalid address")
+	}
+
+	if _, err := ParseFilter(nil, nil, []string{types.Address{}.String()}, nil, nil); err == nil {
+		t.Fatal("Expected error for invalid public key")
+	}
+
+	if _, err := ParseFilter(nil, nil, nil, nil, []string{"Owner"}); err == nil {
+		t.Fatal("Expected error for unknown role")
+	}
+
+	if _, err := ParseGroupBy([]string{"height"}); err == nil {
+		t.Fatal("Expected error for unknown field")
+	}
+}
+
+func TestSetRange(t *testing.T) {
+	var q Query
+	if err := q.SetRange(0, 0, 10); err != nil || q.FromHeight != 10 || q.ToHeight != 10 {
+		t.Fatalf("Expected the last height, got %d-%d, %v", q.FromHeight, q.ToHeight, err)
+	}
+
+	if err := q.SetRange(5, 3, 10); err == nil {
+		t.Fatal("Expected error for reversed range")
+	}
+
+	if err := q.SetRange(1, MaxRange+1, MaxRange+1); err == nil {
+		t.Fatal("Expected error for too big range")
+	}
+}
@@ -0,0 +1,139 @@
+// Package health checks whether the node is alive and ready to serve requests, e.g. for probes of orchestrators
+package health
+
+import (
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	"time"
+)
+
+// Names of checks
+const (
+	CheckTendermint = "tendermint"
+	CheckCatchingUp = "catching_up"
+	CheckBlockAge   = "last_block_age"
+	CheckPeers      = "peers"
+	CheckState      = "state_height"
+	CheckAPI        = "api"
+)
+
+type Check struct {
+	Name    string `json:"name"`
+	Ok      bool   `json:"ok"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is a result of all checks, it is ok only if all checks passed
+type Report struct {
+	Ok     bool     `json:"ok"`
+	Checks []*Check `json:"checks"`
+}
+
+func (r *Report) add(name string, ok bool, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, &Check{Name: name, Ok: ok, Message: fmt.Sprintf(format, args...)})
+	r.Ok = r.Ok && ok
+}
+
+type Checker struct {
+	blockchain  *minter.Blockchain
+	client      *rpc.Local
+	maxBlockAge time.Duration
+	minPeers    int
+}
+
+func NewChecker(blockchain *minter.Blockchain, client *rpc.Local, cfg *config.Config) *Checker {
+	return &Checker{
+		blockchain:  blockchain,
+		client:      client,
+		maxBlockAge: cfg.HealthMaxBlockAge,
+		minPeers:    cfg.HealthMinPeers,
+	}
+}
+
+// Live reports whether the node is running, a failed node should be restarted
+func (c *Checker) Live() *Report {
+	report := &Report{Ok: true}
+
+	if _, err := c.client.Health(); err != nil {
+		report.add(CheckTendermint, false, "%s", err)
+		return report
+	}
+	report.add(CheckTendermint, true, "")
+
+	return report
+}
+
+// Ready reports whether the node is synced and able to serve API requests
+func (c *Checker) Ready() *Report {
+	report := &Report{Ok: true}
+
+	status, err := c.client.Status()
+	if err != nil {
+		report.add(CheckTendermint, false, "%s", err)
+		return report
+	}
+	report.add(CheckTendermint, true, "")
+
+	syncInfo := status.SyncInfo
+	if syncInfo.CatchingUp {
+		report.add(CheckCatchingUp, false, "node is catching up at height %d", syncInfo.LatestBlockHeight)
+	} else {
+		report.add(CheckCatchingUp, true, "")
+	}
+
+	age := time.Since(syncInfo.LatestBlockTime).Truncate(time.Second)
+	if c.maxBlockAge > 0 && age > c.maxBlockAge {
+		report.add(CheckBlockAge, false, "last block is %s old, max %s", age, c.maxBlockAge)
+	} else {
+		report.add(CheckBlockAge, true, "last block is %s old", age)
+	}
+
+	netInfo, err := c.client.NetInfo()
+	if err != nil {
+		report.add(CheckPeers, false, "%s", err)
+	} else if netInfo.NPeers < c.minPeers {
+		report.add(CheckPeers, false, "%d peers, min %d", netInfo.NPeers, c.minPeers)
+	} else {
+		report.add(CheckPeers, true, "%d peers", netInfo.NPeers)
+	}
+
+	// the block is saved by Tendermint before it is executed, so the state may be one block behind
+	committed := c.blockchain.LastCommittedHeight()
+	if latest := uint64(syncInfo.LatestBlockHeight); committed+1 < latest {
+		report.add(CheckState, false, "state is loaded at height %d, latest block is %d", committed, latest)
+	} else {
+		report.add(CheckState, true, "state is loaded at height %d", committed)
+	}
+
+	if err := c.query(committed); err != nil {
+		report.add(CheckAPI, false, "can't query state at height %d: %s", committed, err)
+	} else {
+		report.add(CheckAPI, true, "")
+	}
+
+	return report
+}
+
+// query loads the state at the height the same way API does and reads from it
+func (c *Checker) query(height uint64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	cState, release, err := c.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	cState.RLock()
+	defer cState.RUnlock()
+
+	cState.App.GetMaxGas()
+
+	return nil
+}
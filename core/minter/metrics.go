@@ -0,0 +1,79 @@
+package minter
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/statistics"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"math/big"
+)
+
+// collectEconomics takes a snapshot of chain economics at the end of the block, reward is the distributed reward pool
+func (app *Blockchain) collectEconomics(reward *big.Int) statistics.Economics {
+	economics := statistics.Economics{
+		TotalStake:   big.NewInt(0),
+		RewardPool:   reward,
+		TotalSlashed: app.stateDeliver.App.GetTotalSlashed(),
+		MaxGas:       app.stateDeliver.App.GetMaxGas(),
+	}
+
+	if app.tmNode != nil {
+		economics.MinGasPrice = app.MinGasPrice()
+	}
+
+	for _, candidate := range app.stateDeliver.Candidates.GetCandidates() {
+		economics.TotalStake.Add(economics.TotalStake, app.stateDeliver.Candidates.GetTotalStake(candidate.PubKey))
+	}
+
+	vals := app.stateDeliver.Validators.GetValidators()
+
+	// voting power is a share of present validators, the same as the share of distributed reward
+	totalPower := big.NewInt(0)
+	for _, val := range vals {
+		if !val.IsToDrop() && app.validatorsStatuses[val.GetAddress()] == ValidatorPresent {
+			totalPower.Add(totalPower, val.GetTotalBipStake())
+		}
+	}
+
+	for _, val := range vals {
+		var power int64
+		if totalPower.Sign() == 1 && !val.IsToDrop() && app.validatorsStatuses[val.GetAddress()] == ValidatorPresent {
+			power = big.NewInt(0).Div(big.NewInt(0).Mul(val.GetTotalBipStake(), big.NewInt(100000000)), totalPower).Int64()
+		}
+
+		economics.Validators = append(economics.Validators, statistics.ValidatorEconomics{
+			PubKey:      val.PubKey.String(),
+			VotingPower: power,
+			AccumReward: val.GetAccumReward(),
+		})
+	}
+
+	for _, symbol := range app.cfg.MetricsCoins {
+		coin := app.stateDeliver.Coins.GetCoin(types.StrToCoinSymbol(symbol))
+		if coin == nil {
+			continue
+		}
+
+		economics.Coins = append(economics.Coins, statistics.CoinEconomics{
+			Symbol:  symbol,
+			Reserve: coin.Reserve(),
+			Volume:  coin.Volume(),
+		})
+	}
+
+	return economics
+}
+
+// countTx adds the delivered transaction to statistics by its type and code
+func (app *Blockchain) countTx(tx []byte, response abciTypes.ResponseDeliverTx) {
+	if app.statisticData == nil {
+		return
+	}
+
+	txType := "unknown"
+	if decodedTx, err := transaction.TxDecoder.DecodeFromBytesWithoutSig(tx); err == nil {
+		txType = decodedTx.Type.Name()
+	}
+
+	app.statisticData.AddTx(txType, response.GasUsed, response.Code)
+}
@@ -7,13 +7,19 @@ import (
 	"github.com/MinterTeam/minter-go-node/cmd/utils"
 	"github.com/MinterTeam/minter-go-node/config"
 	"github.com/MinterTeam/minter-go-node/core/appdb"
+	"github.com/MinterTeam/minter-go-node/core/cache"
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/events"
+	"github.com/MinterTeam/minter-go-node/core/payloads"
 	"github.com/MinterTeam/minter-go-node/core/rewards"
 	"github.com/MinterTeam/minter-go-node/core/state"
 	"github.com/MinterTeam/minter-go-node/core/state/candidates"
 	"github.com/MinterTeam/minter-go-node/core/statistics"
+	"github.com/MinterTeam/minter-go-node/core/subscriptions"
 	"github.com/MinterTeam/minter-go-node/core/transaction"
 	"github.com/MinterTeam/minter-go-node/core/types"
 	"github.com/MinterTeam/minter-go-node/core/validators"
+	"github.com/MinterTeam/minter-go-node/core/webhooks"
 	"github.com/MinterTeam/minter-go-node/helpers"
 	"github.com/MinterTeam/minter-go-node/upgrades"
 	"github.com/MinterTeam/minter-go-node/version"
@@ -43,6 +49,9 @@ const (
 
 	DefaultMaxGas = 100000
 	MinMaxGas     = 5000
+
+	// eventsPruneLimit is a maximal number of heights whose events are pruned on commit
+	eventsPruneLimit = 1000
 )
 
 var (
@@ -55,11 +64,34 @@ type Blockchain struct {
 
 	statisticData *statistics.Data
 
+	// responseCache keeps API responses to requests pinned to a height
+	responseCache *cache.ResponseCache
+
+	// updates delivers changes of the state made by blocks to API subscribers
+	updates *subscriptions.Hub
+
+	// blockTxs are successful transactions of the current block
+	blockTxs []subscriptions.Tx
+
+	// deliveredTxs is a number of transactions of the current block delivered so far
+	deliveredTxs uint32
+
+	// payloadIndex is an optional index of transactions by payload and service data
+	payloadIndex *payloads.Index
+
+	// webhooks notifies external endpoints about committed blocks
+	webhooks *webhooks.Dispatcher
+
+	// eventsPruner deletes events older than keep_last_events
+	eventsPruner *events.Pruner
+
 	stateDB            db.DB
 	appDB              *appdb.AppDB
 	eventsDB           eventsdb.IEventsDB
 	stateDeliver       *state.State
 	stateCheck         *state.State
+	stateViews         *state.ViewPool
+	stateArchive       *state.Archive
 	height             uint64   // current Blockchain height
 	rewards            *big.Int // Rewards pool
 	validatorsStatuses map[types.TmAddress]int8
@@ -78,9 +110,7 @@ type Blockchain struct {
 
 // Creates Minter Blockchain instance, should be only called once
 func NewMinterBlockchain(cfg *config.Config) *Blockchain {
-	var err error
-
-	ldb, err := db.NewGoLevelDBWithOpts("state", utils.GetMinterHome()+"/data", getDbOpts(cfg.StateMemAvailable))
+	ldb, err := OpenDB("state", db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data", cfg.StateMemAvailable)
 	if err != nil {
 		panic(err)
 	}
@@ -88,25 +118,51 @@ func NewMinterBlockchain(cfg *config.Config) *Blockchain {
 	// Initiate Application DB. Used for persisting data like current block, validators, etc.
 	applicationDB := appdb.NewAppDB(cfg)
 
-	edb, err := db.NewGoLevelDBWithOpts("events", utils.GetMinterHome()+"/data", getDbOpts(1024))
+	edb, err := OpenDB("events", db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data", 1024)
 	if err != nil {
 		panic(err)
 	}
 
+	var payloadIndex *payloads.Index
+	if cfg.PayloadIndex {
+		pdb, err := OpenDB("payloads", db.BackendType(cfg.DBBackend), utils.GetMinterHome()+"/data", 1024)
+		if err != nil {
+			panic(err)
+		}
+		payloadIndex = payloads.NewIndex(pdb)
+	}
+
+	return NewMinterBlockchainWithDB(cfg, ldb, applicationDB, edb, payloadIndex)
+}
+
+// NewMinterBlockchainWithDB creates Minter Blockchain instance over already opened databases, payload index is optional
+func NewMinterBlockchainWithDB(cfg *config.Config, stateDB db.DB, applicationDB *appdb.AppDB, eventsDB db.DB, payloadIndex *payloads.Index) *Blockchain {
+	var err error
+
 	blockchain = &Blockchain{
-		stateDB:        ldb,
+		stateDB:        stateDB,
 		appDB:          applicationDB,
 		height:         applicationDB.GetLastHeight(),
-		eventsDB:       eventsdb.NewEventsStore(edb),
+		eventsDB:       eventsdb.NewEventsStore(eventsDB),
 		currentMempool: &sync.Map{},
+		responseCache:  cache.NewResponseCache(cfg.APICacheSize, int64(cfg.APICacheMem)*1024*1024),
+		stateViews:     state.NewViewPool(stateDB, cfg.StateViewsPoolSize),
+		updates:        subscriptions.NewHub(applicationDB.GetLastHeight(), int(cfg.KeepLastStates), cfg.RPC.MaxSubscriptionClients),
+		payloadIndex:   payloadIndex,
 		cfg:            cfg,
 	}
 
+	blockchain.eventsPruner, err = events.NewPruner(eventsDB)
+	if err != nil {
+		panic(err)
+	}
+
 	// Set stateDeliver and stateCheck
 	blockchain.stateDeliver, err = state.NewState(blockchain.height, blockchain.stateDB, blockchain.eventsDB, cfg.KeepLastStates, cfg.StateCacheSize)
 	if err != nil {
 		panic(err)
 	}
+	blockchain.stateDeliver.SetRetention(blockchain.retention())
 
 	blockchain.stateCheck = state.NewCheckState(blockchain.stateDeliver)
 
@@ -177,6 +233,7 @@ func (app *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTypes.Res
 		if err != nil {
 			panic(err)
 		}
+		app.stateDeliver.SetRetention(app.retention())
 		app.stateCheck = state.NewCheckState(app.stateDeliver)
 	}
 
@@ -190,6 +247,11 @@ func (app *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTypes.Res
 	atomic.StoreUint64(&app.height, height)
 	app.rewards = big.NewInt(0)
 
+	// index frozen funds by candidates before transactions of the upgrade block can change public keys
+	if height >= upgrades.UpgradeBlock4 {
+		app.stateDeliver.FrozenFunds.IndexCandidates(height)
+	}
+
 	// clear absent candidates
 	app.validatorsStatuses = map[types.TmAddress]int8{}
 
@@ -198,6 +260,9 @@ func (app *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTypes.Res
 		var address types.TmAddress
 		copy(address[:], v.Validator.Address)
 
+		// new public key of a validator is applied by Tendermint with a delay, it votes with the old one meanwhile
+		address = app.stateDeliver.Candidates.CurrentTmAddress(address)
+
 		if v.SignedLastBlock {
 			app.stateDeliver.Validators.SetValidatorPresent(height, address)
 			app.validatorsStatuses[address] = ValidatorPresent
@@ -212,6 +277,9 @@ func (app *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTypes.Res
 		var address types.TmAddress
 		copy(address[:], byzVal.Validator.Address)
 
+		// evidence could be committed by the old public key of the validator
+		address = app.stateDeliver.Candidates.CurrentTmAddress(address)
+
 		// skip already offline candidates to prevent double punishing
 		candidate := app.stateDeliver.Candidates.GetCandidateByTendermintAddress(address)
 		if candidate == nil || candidate.Status == candidates.CandidateStatusOffline {
@@ -251,10 +319,24 @@ func (app *Blockchain) EndBlock(req abciTypes.RequestEndBlock) abciTypes.Respons
 		ApplyUpgrade3(app.stateDeliver, app.eventsDB)
 	}
 
+	// state imported from genesis after the upgrade keeps candidates in the single list until the first block
+	if height >= upgrades.UpgradeBlock4 {
+		app.stateDeliver.Candidates.MigrateToSplitKeys()
+	}
+
 	var updates []abciTypes.ValidatorUpdate
 
 	vals := app.stateDeliver.Validators.GetValidators()
 
+	// validators which changed public key in this block voted with the old one
+	if app.stateDeliver.Validators.HasChangedPubKeys() {
+		statuses := make(map[types.TmAddress]int8, len(app.validatorsStatuses))
+		for address, status := range app.validatorsStatuses {
+			statuses[app.stateDeliver.Candidates.CurrentTmAddress(address)] = status
+		}
+		app.validatorsStatuses = statuses
+	}
+
 	hasDroppedValidators := false
 	for _, val := range vals {
 		if val.IsToDrop() {
@@ -307,11 +389,21 @@ func (app *Blockchain) EndBlock(req abciTypes.RequestEndBlock) abciTypes.Respons
 	// add remainder to total slashed
 	app.stateDeliver.App.AddTotalSlashed(remainder)
 
+	// apply scheduled commission changes before rewards are paid
+	if height >= upgrades.UpgradeBlock4 {
+		app.stateDeliver.Candidates.ApplyCommissionUpdates(height)
+	}
+
 	// pay rewards
 	if req.Height%120 == 0 {
 		app.stateDeliver.Validators.PayRewards(height)
 	}
 
+	// replace changed public keys of validators in Tendermint, the set is recalculated on schedule only
+	if req.Height%120 != 0 && !hasDroppedValidators && app.stateDeliver.Validators.HasChangedPubKeys() {
+		updates = app.replaceValidatorPubKeys()
+	}
+
 	// update validators
 	if req.Height%120 == 0 || hasDroppedValidators {
 		app.stateDeliver.Candidates.RecalculateStakes(height)
@@ -373,6 +465,10 @@ func (app *Blockchain) EndBlock(req abciTypes.RequestEndBlock) abciTypes.Respons
 		}
 	}
 
+	if app.statisticData != nil {
+		app.statisticData.SetEconomics(app.collectEconomics(reward))
+	}
+
 	defer func() { app.StatisticData().SetEndBlockDuration(time.Now(), app.height) }()
 
 	return abciTypes.ResponseEndBlock{
@@ -400,7 +496,18 @@ func (app *Blockchain) Info(req abciTypes.RequestInfo) (resInfo abciTypes.Respon
 func (app *Blockchain) DeliverTx(req abciTypes.RequestDeliverTx) abciTypes.ResponseDeliverTx {
 	response := transaction.RunTx(app.stateDeliver, false, req.Tx, app.rewards, app.height, &sync.Map{}, 0)
 
-	return abciTypes.ResponseDeliverTx{
+	if response.Code == code.OK {
+		app.blockTxs = append(app.blockTxs, subscriptions.Tx{Raw: req.Tx, Tags: response.Tags})
+
+		if app.payloadIndex != nil {
+			if decodedTx, err := transaction.TxDecoder.DecodeFromBytesWithoutSig(req.Tx); err == nil {
+				app.payloadIndex.Add(app.height, app.deliveredTxs, types2.Tx(req.Tx).Hash(), decodedTx.Payload, decodedTx.ServiceData)
+			}
+		}
+	}
+	app.deliveredTxs++
+
+	result := abciTypes.ResponseDeliverTx{
 		Code:      response.Code,
 		Data:      response.Data,
 		Log:       response.Log,
@@ -414,6 +521,9 @@ func (app *Blockchain) DeliverTx(req abciTypes.RequestDeliverTx) abciTypes.Respo
 			},
 		},
 	}
+	app.countTx(req.Tx, result)
+
+	return result
 }
 
 // Validate a tx for the mempool
@@ -444,14 +554,30 @@ func (app *Blockchain) Commit() abciTypes.ResponseCommit {
 		}
 	}
 
+	// Collect changes for subscribers before dirty flags are reset by commit
+	update := subscriptions.Collect(app.stateDeliver, app.height)
+
 	// Committing Minter Blockchain state
 	hash, err := app.stateDeliver.Commit()
 	if err != nil {
 		panic(err)
 	}
+	app.StatisticData().SetCommitDurations(app.stateDeliver.CommitDurations())
 
 	// Flush events db
 	_ = app.eventsDB.CommitEvents()
+	update.Events = app.eventsDB.LoadEvents(uint32(app.height))
+	app.pruneEvents()
+	update.Txs, app.blockTxs = app.blockTxs, nil
+	app.deliveredTxs = 0
+
+	// Flush payload index
+	if err := app.payloadIndex.Commit(); err != nil {
+		panic(err)
+	}
+
+	// Enqueue webhook deliveries before the height is persisted, so they are not lost on crash
+	app.webhooks.Enqueue(update)
 
 	// Persist application hash and height
 	app.appDB.SetLastBlockHash(hash)
@@ -460,11 +586,25 @@ func (app *Blockchain) Commit() abciTypes.ResponseCommit {
 	// Resetting check state to be consistent with current height
 	app.resetCheckState()
 
+	// Drop state views and cached responses for pruned states. Responses of snapshots stay valid forever,
+	// while views of archived snapshots are dropped since their versions are deleted from the state database
+	if keepLastStates := uint64(app.cfg.KeepLastStates); app.height > keepLastStates {
+		retention := app.retention()
+		app.stateViews.Prune(app.height-keepLastStates, func(height uint64) bool {
+			return retention.Archive == nil && retention.IsSnapshot(int64(height))
+		})
+		app.responseCache.Prune(app.height-keepLastStates, func(height uint64) bool {
+			return retention.IsSnapshot(int64(height))
+		})
+	}
+
 	// Clear mempool
 	app.currentMempool = &sync.Map{}
 
 	app.stateDeliver.Unlock()
 
+	app.updates.Publish(update)
+
 	return abciTypes.ResponseCommit{
 		Data: hash,
 	}
@@ -486,6 +626,12 @@ func (app *Blockchain) Stop() {
 	app.stateDB.Close()
 }
 
+// DeliverState returns the state modified by the current block.
+// It should be used only by ABCI methods or between them, e.g. by tools replaying blocks.
+func (app *Blockchain) DeliverState() *state.State {
+	return app.stateDeliver
+}
+
 // Get immutable state of Minter Blockchain
 func (app *Blockchain) CurrentState() *state.State {
 	app.lock.RLock()
@@ -494,17 +640,90 @@ func (app *Blockchain) CurrentState() *state.State {
 	return state.NewCheckState(app.stateCheck)
 }
 
+// pruneEvents deletes events older than keep_last_events. Backlog of events kept before pruning was enabled
+// is deleted by portions, so commit is not delayed.
+func (app *Blockchain) pruneEvents() {
+	keep := uint64(app.cfg.KeepLastEvents)
+	if keep == 0 || app.height <= keep {
+		return
+	}
+
+	if _, err := app.eventsPruner.Prune(app.height-keep+1, eventsPruneLimit); err != nil {
+		panic(err)
+	}
+}
+
+// CheckEventsHeight returns an error if events of the height are pruned
+func (app *Blockchain) CheckEventsHeight(height uint64) error {
+	if app.eventsPruner.IsPruned(height) {
+		return rpctypes.RPCError{Code: 404, Message: fmt.Sprintf("Events at height %d are pruned, the oldest available height is %d", height, app.eventsPruner.Pruned())}
+	}
+
+	return nil
+}
+
 // Get immutable state of Minter Blockchain for given height
-func (app *Blockchain) GetStateForHeight(height uint64) (*state.State, error) {
+// State is shared with concurrent and following readers, release should be called when it is not used anymore.
+func (app *Blockchain) GetStateForHeight(height uint64) (*state.State, func(), error) {
 	app.lock.RLock()
 	defer app.lock.RUnlock()
 
-	s, err := state.NewCheckStateAtHeight(height, app.stateDB)
+	s, release, err := app.stateViews.Acquire(height)
 	if err != nil {
-		return nil, rpctypes.RPCError{Code: 404, Message: "State at given height not found", Data: err.Error()}
+		if height > 0 && height < app.Height() {
+			if nearest := app.nearestStateHeight(height); nearest != 0 {
+				return nil, nil, rpctypes.RPCError{Code: 404, Message: fmt.Sprintf("Height %d is pruned, nearest available is %d", height, nearest), Data: err.Error()}
+			}
+		}
+		return nil, nil, rpctypes.RPCError{Code: 404, Message: "State at given height not found", Data: err.Error()}
 	}
 
-	return s, nil
+	return s, release, nil
+}
+
+// nearestStateHeight returns height of available state closest to the given one, 0 if there is no such state
+func (app *Blockchain) nearestStateHeight(height uint64) uint64 {
+	latest := app.Height()
+
+	return uint64(app.retention().Nearest(int64(height), int64(latest), func(version int64) bool {
+		return app.stateDeliver.HasVersion(version) || app.stateArchive.Has(uint64(version))
+	}))
+}
+
+// retention returns policy of deleting old versions of the state
+func (app *Blockchain) retention() state.Retention {
+	return state.Retention{
+		KeepLast: app.cfg.KeepLastStates,
+		Interval: app.cfg.StateSnapshotInterval,
+		Archive:  app.stateArchive,
+	}
+}
+
+// SetStateArchive sets archive where old snapshots of the state are moved to
+func (app *Blockchain) SetStateArchive(archive *state.Archive) {
+	app.stateArchive = archive
+	app.stateViews.SetArchive(archive)
+	app.stateDeliver.SetRetention(app.retention())
+}
+
+// ResponseCache returns cache of API responses to requests pinned to a height
+func (app *Blockchain) ResponseCache() *cache.ResponseCache {
+	return app.responseCache
+}
+
+// PayloadIndex returns index of transactions by payload and service data, nil if it is disabled
+func (app *Blockchain) PayloadIndex() *payloads.Index {
+	return app.payloadIndex
+}
+
+// SetWebhooks sets dispatcher of notifications about committed blocks
+func (app *Blockchain) SetWebhooks(dispatcher *webhooks.Dispatcher) {
+	app.webhooks = dispatcher
+}
+
+// Updates returns hub of changes of the state made by recent blocks
+func (app *Blockchain) Updates() *subscriptions.Hub {
+	return app.updates
 }
 
 // Get current height of Minter Blockchain
@@ -512,6 +731,11 @@ func (app *Blockchain) Height() uint64 {
 	return atomic.LoadUint64(&app.height)
 }
 
+// LastCommittedHeight returns height of the last state committed to the database
+func (app *Blockchain) LastCommittedHeight() uint64 {
+	return app.appDB.GetLastHeight()
+}
+
 // Set Tendermint node
 func (app *Blockchain) SetTmNode(node *tmNode.Node) {
 	app.tmNode = node
@@ -547,6 +771,41 @@ func (app *Blockchain) resetCheckState() {
 	app.stateCheck = state.NewCheckState(app.stateDeliver)
 }
 
+// replaceValidatorPubKeys returns updates which remove public keys of validators changed in the block from Tendermint
+// and add the new ones with the same power. Saved validators set is updated accordingly.
+func (app *Blockchain) replaceValidatorPubKeys() []abciTypes.ValidatorUpdate {
+	var updates []abciTypes.ValidatorUpdate
+
+	changes := app.stateDeliver.Validators.ChangedPubKeys()
+
+	current := app.getCurrentValidators()
+	for i, validator := range current {
+		old := types.BytesToPubkey(validator.PubKey.Data)
+
+		// the key may be changed several times in the block
+		pubkey := old
+		for _, change := range changes {
+			if change.Old == pubkey {
+				pubkey = change.New
+			}
+		}
+
+		if pubkey == old {
+			continue
+		}
+
+		current[i] = abciTypes.Ed25519ValidatorUpdate(pubkey[:], validator.Power)
+		updates = append(updates, abciTypes.ValidatorUpdate{
+			PubKey: validator.PubKey,
+			Power:  0,
+		}, current[i])
+	}
+
+	app.saveCurrentValidators(current)
+
+	return updates
+}
+
 func (app *Blockchain) getCurrentValidators() abciTypes.ValidatorUpdates {
 	return app.appDB.GetValidators()
 }
@@ -627,6 +886,23 @@ func (app *Blockchain) StatisticData() *statistics.Data {
 	return app.statisticData
 }
 
+// OpenDB opens the database of the given backend. Goleveldb databases are tuned to use about memLimit megabytes of memory,
+// other backends use their defaults. Unknown backends and backends which are not compiled in return an error.
+func OpenDB(name string, backend db.BackendType, dir string, memLimit int) (database db.DB, err error) {
+	if backend == db.GoLevelDBBackend {
+		return db.NewGoLevelDBWithOpts(name, dir, getDbOpts(memLimit))
+	}
+
+	// db.NewDB panics on unknown backends and on errors of opening
+	defer func() {
+		if r := recover(); r != nil {
+			database, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+
+	return db.NewDB(name, backend, dir), nil
+}
+
 func getDbOpts(memLimit int) *opt.Options {
 	if memLimit < 1024 {
 		panic(fmt.Sprintf("Not enough memory given to StateDB. Expected >1024M, given %d", memLimit))
@@ -0,0 +1,215 @@
+// Package payloads implements index of successful transactions by their payload and service data.
+// Index supports lookup by exact value and by prefix, results are ordered by value and then by height.
+package payloads
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	db "github.com/tendermint/tm-db"
+	"sync"
+)
+
+type Field byte
+
+const (
+	FieldPayload     Field = 'p'
+	FieldServiceData Field = 's'
+
+	// suffix of a key after the indexed value: height and index of tx in the block
+	keySuffixLength = 8 + 4
+)
+
+// Indexed value is escaped and terminated in keys, so keys of equal values are kept together and
+// ordered by height. Zero bytes of the value are escaped as 0x00 0xff, the terminator 0x00 0x01 sorts
+// before them, so values are ordered as raw bytes and the escaped prefix of a value is a prefix of its key.
+var (
+	escapedZero = []byte{0x00, 0xff}
+	terminator  = []byte{0x00, 0x01}
+)
+
+var ErrDisabled = errors.New("payload index is disabled")
+
+// ParseField returns field by its API name, empty name means payload
+func ParseField(name string) (Field, error) {
+	switch name {
+	case "", "payload":
+		return FieldPayload, nil
+	case "service_data":
+		return FieldServiceData, nil
+	}
+
+	return 0, fmt.Errorf("unknown field %q, should be payload or service_data", name)
+}
+
+// Entry is a transaction found by its payload or service data
+type Entry struct {
+	Value  []byte
+	Height uint64
+	Index  uint32
+	Hash   []byte
+}
+
+// Index is stored in its own database. Nil index is valid, it ignores transactions and returns ErrDisabled.
+type Index struct {
+	db db.DB
+
+	lock    sync.Mutex
+	pending map[string][]byte
+}
+
+func NewIndex(db db.DB) *Index {
+	return &Index{
+		db:      db,
+		pending: map[string][]byte{},
+	}
+}
+
+// Add indexes transaction of the current block, it is written to the database on Commit
+func (i *Index) Add(height uint64, index uint32, hash []byte, payload []byte, serviceData []byte) {
+	if i == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for field, value := range map[Field][]byte{FieldPayload: payload, FieldServiceData: serviceData} {
+		if len(value) == 0 {
+			continue
+		}
+
+		i.pending[string(key(searchPrefix(field, value, true), height, index))] = hash
+	}
+}
+
+// Commit writes transactions of the block to the database. The batch is synced, so the index
+// is not behind the persisted height of the application after a crash.
+func (i *Index) Commit() error {
+	if i == nil {
+		return nil
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if len(i.pending) == 0 {
+		return nil
+	}
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	for k, hash := range i.pending {
+		batch.Set([]byte(k), hash)
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	i.pending = map[string][]byte{}
+	return nil
+}
+
+// Find returns up to limit transactions whose field equals the value or, if prefix is true, starts with it.
+// The first offset results are skipped.
+func (i *Index) Find(field Field, value []byte, prefix bool, offset, limit int) ([]Entry, error) {
+	if i == nil {
+		return nil, ErrDisabled
+	}
+
+	if len(value) == 0 || len(value) > 0xffff {
+		return nil, errors.New("invalid length of value")
+	}
+
+	start := searchPrefix(field, value, !prefix)
+	it, err := i.db.Iterator(start, prefixEnd(start))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	result := make([]Entry, 0, limit)
+	for ; it.Valid() && len(result) < limit; it.Next() {
+		k := it.Key()
+
+		entryValue := value
+		if prefix {
+			entryValue = unescape(k[1 : len(k)-keySuffixLength-len(terminator)])
+		}
+
+		if offset > 0 {
+			offset--
+			continue
+		}
+
+		suffix := k[len(k)-keySuffixLength:]
+		result = append(result, Entry{
+			Value:  entryValue,
+			Height: binary.BigEndian.Uint64(suffix[:8]),
+			Index:  binary.BigEndian.Uint32(suffix[8:]),
+			Hash:   append([]byte{}, it.Value()...),
+		})
+	}
+
+	return result, nil
+}
+
+// searchPrefix returns common prefix of keys of the value, terminated if the value should be equal
+func searchPrefix(field Field, value []byte, exact bool) []byte {
+	k := make([]byte, 1, 1+len(value)+len(terminator))
+	k[0] = byte(field)
+
+	for _, b := range value {
+		if b == 0 {
+			k = append(k, escapedZero...)
+			continue
+		}
+		k = append(k, b)
+	}
+
+	if exact {
+		k = append(k, terminator...)
+	}
+
+	return k
+}
+
+// unescape returns the value from its escaped representation in a key
+func unescape(escaped []byte) []byte {
+	value := make([]byte, 0, len(escaped))
+	for j := 0; j < len(escaped); j++ {
+		value = append(value, escaped[j])
+		if escaped[j] == 0 {
+			j++
+		}
+	}
+
+	return value
+}
+
+func key(prefix []byte, height uint64, index uint32) []byte {
+	k := make([]byte, 0, len(prefix)+keySuffixLength)
+	k = append(k, prefix...)
+	k = append(k, make([]byte, keySuffixLength)...)
+
+	suffix := k[len(k)-keySuffixLength:]
+	binary.BigEndian.PutUint64(suffix[:8], height)
+	binary.BigEndian.PutUint32(suffix[8:], index)
+
+	return k
+}
+
+// prefixEnd returns the smallest key greater than all keys starting with the prefix
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for j := len(end) - 1; j >= 0; j-- {
+		if end[j] != 0xff {
+			end[j]++
+			return end[:j+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package payloads
+
+import (
+	"bytes"
+	db "github.com/tendermint/tm-db"
+	"testing"
+)
+
+func TestIndexFind(t *testing.T) {
+	index := NewIndex(db.NewMemDB())
+
+	index.Add(1, 0, []byte{1}, []byte("order:1"), nil)
+	index.Add(1, 1, []byte{2}, []byte("order:10"), []byte("order:1"))
+	index.Add(2, 0, []byte{3}, []byte("order:1"), nil)
+	index.Add(2, 1, []byte{4}, []byte("other"), nil)
+
+	if entries, _ := index.Find(FieldPayload, []byte("order:1"), false, 0, 10); len(entries) != 0 {
+		t.Fatalf("Transactions should not be found before commit")
+	}
+
+	if err := index.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := index.Find(FieldPayload, []byte("order:1"), false, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Height != 1 || entries[1].Height != 2 || !bytes.Equal(entries[1].Hash, []byte{3}) {
+		t.Fatalf("Exact search should return only equal payloads ordered by height, got %+v", entries)
+	}
+
+	entries, err = index.Find(FieldPayload, []byte("order:1"), true, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || string(entries[2].Value) != "order:10" || entries[2].Index != 1 {
+		t.Fatalf("Prefix search should return payloads ordered by value and height, got %+v", entries)
+	}
+
+	entries, err = index.Find(FieldPayload, []byte("order:1"), true, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Height != 2 {
+		t.Fatalf("Wrong page of results, got %+v", entries)
+	}
+
+	entries, err = index.Find(FieldServiceData, []byte("order"), true, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !bytes.Equal(entries[0].Hash, []byte{2}) {
+		t.Fatalf("Service data should be indexed separately from payload, got %+v", entries)
+	}
+
+	if _, err := index.Find(FieldPayload, nil, true, 0, 10); err == nil {
+		t.Fatalf("Expected error for empty value")
+	}
+}
+
+func TestIndexFindBinaryValues(t *testing.T) {
+	index := NewIndex(db.NewMemDB())
+
+	index.Add(1, 0, []byte{1}, []byte{'a', 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}, nil)
+	index.Add(2, 0, []byte{2}, []byte{'a'}, nil)
+	index.Add(3, 0, []byte{3}, []byte{'a', 0}, nil)
+	if err := index.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := index.Find(FieldPayload, []byte{'a'}, false, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Height != 2 {
+		t.Fatalf("Exact search should not match values which start with the value, got %+v", entries)
+	}
+
+	entries, err = index.Find(FieldPayload, []byte{'a'}, true, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Height != 2 || entries[1].Height != 3 || entries[2].Height != 1 {
+		t.Fatalf("Prefix search should return values ordered as bytes, got %+v", entries)
+	}
+
+	if !bytes.Equal(entries[2].Value, []byte{'a', 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}) {
+		t.Fatalf("Wrong value of the entry, got %x", entries[2].Value)
+	}
+
+	entries, err = index.Find(FieldPayload, []byte{'a', 0}, false, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Height != 3 {
+		t.Fatalf("Exact search should match value with zero byte, got %+v", entries)
+	}
+}
+
+func TestIndexDisabled(t *testing.T) {
+	var index *Index
+
+	index.Add(1, 0, []byte{1}, []byte("payload"), nil)
+	if err := index.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := index.Find(FieldPayload, []byte("payload"), false, 0, 10); err != ErrDisabled {
+		t.Fatalf("Expected ErrDisabled, got %v", err)
+	}
+}
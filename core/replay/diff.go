@@ -0,0 +1,224 @@
+package replay
+
+import (
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// none is a value of entities which don't exist
+const none = "none"
+
+// Values are string representations of parts of a state entity, e.g. a candidate and its stakes
+type Values map[string]string
+
+// Change is a changed value of the state
+type Change struct {
+	Key    string
+	Before string
+	After  string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Key, c.Before, c.After)
+}
+
+// tracker finds changes of the state made by each step of the block. Values of entities changed by previous steps
+// are remembered, values of other entities are taken from the state committed before the block.
+type tracker struct {
+	committed *state.State
+	last      map[string]Values
+}
+
+func newTracker(committed *state.State) *tracker {
+	committed.Candidates.LoadCandidates()
+
+	return &tracker{committed: committed, last: map[string]Values{}}
+}
+
+// step returns changes made since the previous step
+func (t *tracker) step(s *state.State) []Change {
+	var changes []Change
+	track := func(entity string, values func(s *state.State) Values) {
+		before, ok := t.last[entity]
+		if !ok {
+			before = values(t.committed)
+		}
+
+		after := values(s)
+		changes = append(changes, diff(before, after)...)
+		t.last[entity] = after
+	}
+
+	for _, address := range s.Accounts.DirtyAccounts() {
+		address := address
+		track("account "+address.String(), func(s *state.State) Values { return accountValues(s, address) })
+	}
+
+	for _, symbol := range s.Coins.DirtyCoins() {
+		symbol := symbol
+		track("coin "+symbol.String(), func(s *state.State) Values { return coinValues(s, symbol) })
+	}
+
+	for _, pubkey := range s.Candidates.DirtyCandidates() {
+		pubkey := pubkey
+		track("candidate "+pubkey.String(), func(s *state.State) Values { return candidateValues(s, pubkey) })
+	}
+
+	for _, height := range s.FrozenFunds.DirtyFrozenFunds() {
+		height := height
+		track(fmt.Sprintf("frozen funds %d", height), func(s *state.State) Values { return frozenFundsValues(s, height) })
+	}
+
+	// checker deltas are accumulated by the block, so there are no committed values
+	after := checkerValues(s)
+	changes = append(changes, diff(t.last["checker"], after)...)
+	t.last["checker"] = after
+
+	return changes
+}
+
+// diff compares values by keys, missing values are shown as none
+func diff(before, after Values) []Change {
+	keys := map[string]struct{}{}
+	for key := range before {
+		keys[key] = struct{}{}
+	}
+	for key := range after {
+		keys[key] = struct{}{}
+	}
+
+	var changes []Change
+	for key := range keys {
+		b, ok := before[key]
+		if !ok {
+			b = none
+		}
+
+		a, ok := after[key]
+		if !ok {
+			a = none
+		}
+
+		if a != b {
+			changes = append(changes, Change{Key: key, Before: b, After: a})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+func accountValues(s *state.State, address types.Address) Values {
+	values := Values{}
+
+	if nonce := s.Accounts.GetNonce(address); nonce != 0 {
+		values["nonce "+address.String()] = fmt.Sprintf("%d", nonce)
+	}
+
+	for coin, balance := range s.Accounts.GetBalances(address) {
+		if balance.Sign() != 0 {
+			values["balance "+address.String()+" "+coin.String()] = balance.String()
+		}
+	}
+
+	if account := s.Accounts.GetAccount(address); account != nil && account.IsMultisig() {
+		multisig := account.Multisig()
+		values["multisig "+address.String()] = fmt.Sprintf("threshold=%d weights=%v addresses=%s",
+			multisig.Threshold, multisig.Weights, joinAddresses(multisig.Addresses))
+	}
+
+	return values
+}
+
+func coinValues(s *state.State, symbol types.CoinSymbol) Values {
+	coin := s.Coins.GetCoin(symbol)
+	if coin == nil {
+		return Values{}
+	}
+
+	return Values{
+		"coin " + symbol.String(): fmt.Sprintf("volume=%s reserve=%s crr=%d max_supply=%s",
+			coin.Volume(), coin.Reserve(), coin.Crr(), coin.MaxSupply()),
+	}
+}
+
+func candidateValues(s *state.State, pubkey types.Pubkey) Values {
+	candidate := s.Candidates.GetCandidate(pubkey)
+	if candidate == nil {
+		return Values{}
+	}
+	s.Candidates.LoadStakesOfCandidate(pubkey)
+
+	values := Values{
+		"candidate " + pubkey.String(): fmt.Sprintf("status=%d commission=%d owner=%s reward=%s total_stake=%s",
+			candidate.Status, candidate.Commission, candidate.OwnerAddress.String(), candidate.RewardAddress.String(),
+			bigString(candidate.GetTotalBipStake())),
+	}
+
+	if update := candidate.GetCommissionUpdate(); update != nil {
+		values["commission update "+pubkey.String()] = fmt.Sprintf("commission=%d height=%d", update.Commission, update.Height)
+	}
+
+	for _, stake := range s.Candidates.GetStakes(pubkey) {
+		key := fmt.Sprintf("stake %s %s %s", pubkey.String(), stake.Owner.String(), stake.Coin.String())
+		values[key] = fmt.Sprintf("value=%s bip_value=%s", bigString(stake.Value), bigString(stake.BipValue))
+	}
+
+	return values
+}
+
+func frozenFundsValues(s *state.State, height uint64) Values {
+	funds := s.FrozenFunds.GetFrozenFunds(height)
+	if funds == nil || funds.IsDeleted() {
+		return Values{}
+	}
+
+	values := Values{}
+	for i, item := range funds.List {
+		candidate := none
+		if item.CandidateKey != nil {
+			candidate = item.CandidateKey.String()
+		}
+
+		values[fmt.Sprintf("frozen funds %d #%d", height, i)] = fmt.Sprintf("address=%s candidate=%s coin=%s value=%s",
+			item.Address.String(), candidate, item.Coin.String(), bigString(item.Value))
+	}
+
+	return values
+}
+
+func checkerValues(s *state.State) Values {
+	values := Values{}
+	for coin, delta := range s.Checker.Deltas() {
+		values["checker delta "+coin.String()] = bigString(delta)
+	}
+	for coin, delta := range s.Checker.VolumeDeltas() {
+		values["checker volume delta "+coin.String()] = bigString(delta)
+	}
+
+	return values
+}
+
+func joinAddresses(addresses []types.Address) string {
+	result := make([]string, len(addresses))
+	for i, address := range addresses {
+		result[i] = address.String()
+	}
+
+	return strings.Join(result, ",")
+}
+
+func bigString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
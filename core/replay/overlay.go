@@ -0,0 +1,270 @@
+package replay
+
+import (
+	"bytes"
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	deletedMark byte = iota
+	setMark
+)
+
+// overlayDB reads from the base database and keeps all writes in memory, so the base is never modified.
+// Changes are stored in memdb with a mark of deletion as the first byte of the value.
+type overlayDB struct {
+	base    db.DB
+	changes *db.MemDB
+}
+
+// NewOverlayDB returns a database whose writes are kept in memory on top of the base. Closing it closes the base.
+func NewOverlayDB(base db.DB) db.DB {
+	return &overlayDB{base: base, changes: db.NewMemDB()}
+}
+
+func (o *overlayDB) Get(key []byte) ([]byte, error) {
+	change, err := o.changes.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if change != nil {
+		if change[0] == deletedMark {
+			return nil, nil
+		}
+
+		return change[1:], nil
+	}
+
+	return o.base.Get(key)
+}
+
+func (o *overlayDB) Has(key []byte) (bool, error) {
+	value, err := o.Get(key)
+	return value != nil, err
+}
+
+func (o *overlayDB) Set(key []byte, value []byte) error {
+	return o.changes.Set(key, append([]byte{setMark}, value...))
+}
+
+func (o *overlayDB) SetSync(key []byte, value []byte) error {
+	return o.Set(key, value)
+}
+
+func (o *overlayDB) Delete(key []byte) error {
+	return o.changes.Set(key, []byte{deletedMark})
+}
+
+func (o *overlayDB) DeleteSync(key []byte) error {
+	return o.Delete(key)
+}
+
+func (o *overlayDB) Iterator(start, end []byte) (db.Iterator, error) {
+	base, err := o.base.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := o.changes.Iterator(start, end)
+	if err != nil {
+		base.Close()
+		return nil, err
+	}
+
+	return newMergedIterator(base, changes, false), nil
+}
+
+func (o *overlayDB) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	base, err := o.base.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := o.changes.ReverseIterator(start, end)
+	if err != nil {
+		base.Close()
+		return nil, err
+	}
+
+	return newMergedIterator(base, changes, true), nil
+}
+
+func (o *overlayDB) Close() error {
+	if err := o.changes.Close(); err != nil {
+		return err
+	}
+
+	return o.base.Close()
+}
+
+func (o *overlayDB) NewBatch() db.Batch {
+	return &overlayBatch{db: o}
+}
+
+func (o *overlayDB) Print() error {
+	return o.changes.Print()
+}
+
+func (o *overlayDB) Stats() map[string]string {
+	stats := o.base.Stats()
+	stats["overlay.size"] = o.changes.Stats()["database.size"]
+
+	return stats
+}
+
+type overlayOperation struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// overlayBatch applies operations to the overlay on write, memdb has no atomicity anyway
+type overlayBatch struct {
+	db         *overlayDB
+	operations []overlayOperation
+}
+
+func (b *overlayBatch) Set(key, value []byte) {
+	b.operations = append(b.operations, overlayOperation{key: key, value: value})
+}
+
+func (b *overlayBatch) Delete(key []byte) {
+	b.operations = append(b.operations, overlayOperation{key: key, delete: true})
+}
+
+func (b *overlayBatch) Write() error {
+	for _, operation := range b.operations {
+		var err error
+		if operation.delete {
+			err = b.db.Delete(operation.key)
+		} else {
+			err = b.db.Set(operation.key, operation.value)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	b.operations = nil
+
+	return nil
+}
+
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+func (b *overlayBatch) Close() {
+	b.operations = nil
+}
+
+// mergedIterator iterates over keys of the base and the changes, changes shadow the base
+type mergedIterator struct {
+	base    db.Iterator
+	changes db.Iterator
+	reverse bool
+
+	valid bool
+	key   []byte
+	value []byte
+}
+
+func newMergedIterator(base, changes db.Iterator, reverse bool) *mergedIterator {
+	it := &mergedIterator{base: base, changes: changes, reverse: reverse}
+	it.advance()
+
+	return it
+}
+
+// advance moves the cursor to the next key which is not deleted
+func (it *mergedIterator) advance() {
+	for {
+		baseValid, changesValid := it.base.Valid(), it.changes.Valid()
+		if !baseValid && !changesValid {
+			it.valid = false
+			return
+		}
+
+		if !changesValid || baseValid && it.before(it.base.Key(), it.changes.Key()) {
+			it.valid, it.key, it.value = true, copyBytes(it.base.Key()), copyBytes(it.base.Value())
+			it.base.Next()
+			return
+		}
+
+		if baseValid && bytes.Equal(it.base.Key(), it.changes.Key()) {
+			it.base.Next()
+		}
+
+		key, change := copyBytes(it.changes.Key()), it.changes.Value()
+		it.changes.Next()
+
+		if change[0] == deletedMark {
+			continue
+		}
+
+		it.valid, it.key, it.value = true, key, copyBytes(change[1:])
+		return
+	}
+}
+
+// before returns true if key a goes before key b in order of iteration
+func (it *mergedIterator) before(a, b []byte) bool {
+	if it.reverse {
+		return bytes.Compare(a, b) > 0
+	}
+
+	return bytes.Compare(a, b) < 0
+}
+
+func (it *mergedIterator) Domain() (start []byte, end []byte) {
+	return it.base.Domain()
+}
+
+func (it *mergedIterator) Valid() bool {
+	return it.valid
+}
+
+func (it *mergedIterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	it.advance()
+}
+
+func (it *mergedIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.key
+}
+
+func (it *mergedIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.value
+}
+
+func (it *mergedIterator) Error() error {
+	if err := it.base.Error(); err != nil {
+		return err
+	}
+
+	return it.changes.Error()
+}
+
+func (it *mergedIterator) Close() {
+	it.base.Close()
+	it.changes.Close()
+}
+
+func copyBytes(b []byte) []byte {
+	result := make([]byte, len(b))
+	copy(result, b)
+
+	return result
+}
@@ -0,0 +1,68 @@
+package replay
+
+import (
+	db "github.com/tendermint/tm-db"
+	"testing"
+)
+
+func TestOverlayDB(t *testing.T) {
+	base := db.NewMemDB()
+	base.Set([]byte("a"), []byte("1"))
+	base.Set([]byte("b"), []byte("2"))
+	base.Set([]byte("d"), []byte("4"))
+
+	overlay := NewOverlayDB(base)
+	_ = overlay.Set([]byte("c"), []byte("3"))
+	_ = overlay.Set([]byte("d"), []byte("5"))
+	_ = overlay.Delete([]byte("a"))
+
+	batch := overlay.NewBatch()
+	batch.Set([]byte("e"), []byte("6"))
+	batch.Delete([]byte("b"))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+
+	if value, _ := overlay.Get([]byte("a")); value != nil {
+		t.Errorf("deleted key a has value %s", value)
+	}
+
+	if value, _ := overlay.Get([]byte("d")); string(value) != "5" {
+		t.Errorf("expected value of d is 5, got %s", value)
+	}
+
+	if value, _ := base.Get([]byte("d")); string(value) != "4" {
+		t.Errorf("base is modified, value of d is %s", value)
+	}
+
+	if has, _ := base.Has([]byte("c")); has {
+		t.Error("base is modified, c is set")
+	}
+
+	it, err := overlay.Iterator(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := iterate(it); got != "c=3,d=5,e=6," {
+		t.Errorf("unexpected keys %s", got)
+	}
+
+	it, err = overlay.ReverseIterator([]byte("b"), []byte("e"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := iterate(it); got != "d=5,c=3," {
+		t.Errorf("unexpected keys of reverse iterator %s", got)
+	}
+}
+
+func iterate(it db.Iterator) string {
+	defer it.Close()
+
+	result := ""
+	for ; it.Valid(); it.Next() {
+		result += string(it.Key()) + "=" + string(it.Value()) + ","
+	}
+
+	return result
+}
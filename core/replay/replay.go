@@ -0,0 +1,223 @@
+// Package replay re-executes blocks stored by Tendermint against a copy of the state and reports
+// changes made by each transaction. Databases of the node are never modified, all writes are kept in memory.
+package replay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/appdb"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/tree"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	db "github.com/tendermint/tm-db"
+	"io"
+)
+
+// blocksTimeDeltaCount is a number of blocks used by the app to compute the time delta for max gas
+const blocksTimeDeltaCount = 3
+
+// Databases are databases of the node used by the replay. State, app and events databases are
+// wrapped into in-memory overlays, block store and Tendermint state are only read.
+type Databases struct {
+	State      db.DB
+	App        db.DB
+	Events     db.DB
+	BlockStore db.DB
+	TmState    db.DB
+}
+
+// Replayer executes stored blocks one by one starting from the state committed before the first block
+type Replayer struct {
+	app        *minter.Blockchain
+	tracer     *tracer
+	proxyApp   proxy.AppConns
+	consensus  proxy.AppConnConsensus
+	blockStore *store.BlockStore
+	stateDB    db.DB
+	dbs        Databases
+	height     uint64
+	out        io.Writer
+}
+
+// New prepares the replay of blocks starting from the given height, the state at the previous height should be kept
+func New(cfg *config.Config, dbs Databases, from uint64, out io.Writer) (*Replayer, error) {
+	if from < 2 {
+		return nil, errors.New("replay should start from height 2 or higher, the first block requires the genesis")
+	}
+
+	blockStore := store.NewBlockStore(dbs.BlockStore)
+	if uint64(blockStore.Height()) < from {
+		return nil, fmt.Errorf("block %d is not stored, the last stored block is %d", from, blockStore.Height())
+	}
+
+	base := from - 1
+	stateDB := NewOverlayDB(dbs.State)
+	if _, err := tree.NewMutableTree(stateDB, 1024).LoadVersionForOverwriting(int64(base)); err != nil {
+		return nil, fmt.Errorf("state at height %d is not available: %s", base, err)
+	}
+
+	applicationDB := appdb.NewAppDBFromDB(NewOverlayDB(dbs.App))
+	applicationDB.SetLastHeight(base)
+	applicationDB.SetLastBlockHash(blockStore.LoadBlockMeta(int64(from)).Header.AppHash)
+
+	app := minter.NewMinterBlockchainWithDB(cfg, stateDB, applicationDB, NewOverlayDB(dbs.Events), nil)
+	t := &tracer{Blockchain: app, out: out}
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(t))
+	if err := proxyApp.Start(); err != nil {
+		app.Stop()
+		return nil, err
+	}
+
+	return &Replayer{
+		app:        app,
+		tracer:     t,
+		proxyApp:   proxyApp,
+		consensus:  proxyApp.Consensus(),
+		blockStore: blockStore,
+		stateDB:    stateDB,
+		dbs:        dbs,
+		height:     base,
+		out:        out,
+	}, nil
+}
+
+// Run executes blocks up to the given height inclusive. It stops with an error at the first block
+// whose app hash differs from the one recorded in the header of the next stored block.
+func (r *Replayer) Run(to uint64) error {
+	if last := uint64(r.blockStore.Height()); to > last {
+		return fmt.Errorf("block %d is not stored, the last stored block is %d", to, last)
+	}
+
+	for r.height < to {
+		if err := r.next(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// next executes the block following the current height and checks its app hash
+func (r *Replayer) next() (err error) {
+	height := r.height + 1
+
+	block := r.blockStore.LoadBlock(int64(height))
+	if block == nil {
+		return fmt.Errorf("block %d is not stored", height)
+	}
+
+	committed, err := state.NewCheckStateAtHeight(height-1, r.stateDB)
+	if err != nil {
+		return fmt.Errorf("can't load state at height %d: %s", height-1, err)
+	}
+	r.tracer.tracker = newTracker(committed)
+
+	if delta, ok := r.blocksTimeDelta(height); ok {
+		r.app.SetBlocksTimeDelta(height, delta)
+	}
+
+	_, _ = fmt.Fprintf(r.out, "Block %d, %d txs\n", height, len(block.Txs))
+
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("block %d panicked: %v", height, e)
+		}
+	}()
+
+	hash, err := sm.ExecCommitBlock(r.consensus, block, log.NewNopLogger(), r.dbs.TmState)
+	if err != nil {
+		return fmt.Errorf("can't execute block %d: %s", height, err)
+	}
+	r.height = height
+
+	reference := r.blockStore.LoadBlockMeta(int64(height) + 1)
+	if reference == nil {
+		_, _ = fmt.Fprintf(r.out, "  app hash %X, no reference\n", hash)
+		return nil
+	}
+
+	if expected := reference.Header.AppHash; !bytes.Equal(hash, expected) {
+		return fmt.Errorf("app hash mismatch at height %d: expected %X, got %X", height, []byte(expected), hash)
+	}
+
+	_, _ = fmt.Fprintf(r.out, "  app hash %X\n", hash)
+
+	return nil
+}
+
+// blocksTimeDelta computes the time delta the node computes in BeginBlock using its block store
+func (r *Replayer) blocksTimeDelta(height uint64) (int, bool) {
+	if int64(height)-blocksTimeDeltaCount-1 < 1 {
+		return 0, false
+	}
+
+	blockA := r.blockStore.LoadBlockMeta(int64(height) - blocksTimeDeltaCount - 1)
+	blockB := r.blockStore.LoadBlockMeta(int64(height) - 1)
+	if blockA == nil || blockB == nil {
+		return 0, false
+	}
+
+	return int(blockB.Header.Time.Sub(blockA.Header.Time).Seconds()), true
+}
+
+// Height returns the height of the last replayed block
+func (r *Replayer) Height() uint64 {
+	return r.height
+}
+
+// Close stops the application and closes all databases
+func (r *Replayer) Close() {
+	_ = r.proxyApp.Stop()
+	r.app.Stop()
+	_ = r.dbs.Events.Close()
+	_ = r.dbs.BlockStore.Close()
+	_ = r.dbs.TmState.Close()
+}
+
+// tracer prints changes of the state after each step of the block
+type tracer struct {
+	*minter.Blockchain
+
+	tracker *tracker
+	out     io.Writer
+	txs     int
+}
+
+func (t *tracer) BeginBlock(req abciTypes.RequestBeginBlock) abciTypes.ResponseBeginBlock {
+	t.txs = 0
+	resp := t.Blockchain.BeginBlock(req)
+	t.print("begin block")
+
+	return resp
+}
+
+func (t *tracer) DeliverTx(req abciTypes.RequestDeliverTx) abciTypes.ResponseDeliverTx {
+	resp := t.Blockchain.DeliverTx(req)
+	t.print(fmt.Sprintf("tx %d Mt%x code %d", t.txs, tmTypes.Tx(req.Tx).Hash(), resp.Code))
+	t.txs++
+
+	return resp
+}
+
+func (t *tracer) EndBlock(req abciTypes.RequestEndBlock) abciTypes.ResponseEndBlock {
+	resp := t.Blockchain.EndBlock(req)
+	t.print("end block")
+
+	return resp
+}
+
+func (t *tracer) print(step string) {
+	_, _ = fmt.Fprintf(t.out, "  %s\n", step)
+	for _, change := range t.tracker.step(t.DeliverState()) {
+		_, _ = fmt.Fprintf(t.out, "    %s\n", change)
+	}
+}
@@ -112,6 +112,36 @@ func (a *Accounts) getOrderedDirtyAccounts() []types.Address {
 	return keys
 }
 
+// DirtyAccounts returns addresses of accounts which were changed since the last commit.
+// It should be called before Commit, which resets dirty flags.
+func (a *Accounts) DirtyAccounts() []types.Address {
+	return a.getOrderedDirtyAccounts()
+}
+
+// DirtyBalances returns balances which were changed since the last commit.
+// It should be called before Commit, which resets dirty flags.
+func (a *Accounts) DirtyBalances() map[types.Address]map[types.CoinSymbol]*big.Int {
+	result := map[types.Address]map[types.CoinSymbol]*big.Int{}
+	for _, address := range a.getOrderedDirtyAccounts() {
+		account := a.getFromMap(address)
+		if !account.hasDirtyBalances() {
+			continue
+		}
+
+		balances := make(map[types.CoinSymbol]*big.Int, len(account.dirtyBalances))
+		for coin := range account.dirtyBalances {
+			balance := account.getBalance(coin)
+			if balance == nil {
+				balance = big.NewInt(0)
+			}
+			balances[coin] = big.NewInt(0).Set(balance)
+		}
+		result[address] = balances
+	}
+
+	return result
+}
+
 func (a *Accounts) AddBalance(address types.Address, coin types.CoinSymbol, amount *big.Int) {
 	balance := a.GetBalance(address, coin)
 	a.SetBalance(address, coin, big.NewInt(0).Add(balance, amount))
@@ -335,6 +365,94 @@ func (a *Accounts) Export(state *types.AppState) {
 	})
 }
 
+// IterateAccounts calls fn for committed accounts in order of addresses starting from the cursor,
+// nil cursor means the first account. Cursor passed to fn points to the next account.
+// Iteration stops when fn returns true.
+func (a *Accounts) IterateAccounts(cursor []byte, fn func(account types.Account, next []byte) bool) error {
+	start := []byte{mainPrefix}
+	if cursor != nil {
+		if len(cursor) == 0 || cursor[0] != mainPrefix {
+			return fmt.Errorf("invalid cursor %x", cursor)
+		}
+		start = cursor
+	}
+
+	var current *types.Account
+	flush := func() bool {
+		if current == nil {
+			return false
+		}
+
+		account := *current
+		current = nil
+
+		// the smallest key after all keys of the account
+		next := append([]byte{mainPrefix}, account.Address[:]...)
+		for i := len(next) - 1; i >= 0; i-- {
+			next[i]++
+			if next[i] != 0 {
+				break
+			}
+		}
+
+		return fn(account, next)
+	}
+
+	var err error
+	stopped := a.iavl.IterateRange(start, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) < 1+types.AddressLength {
+			return false
+		}
+
+		address := types.BytesToAddress(key[1 : 1+types.AddressLength])
+		if current == nil || current.Address != address {
+			if flush() {
+				return true
+			}
+			current = &types.Account{Address: address}
+		}
+
+		if len(key) == 1+types.AddressLength {
+			model := &Model{}
+			if err = rlp.DecodeBytes(value, model); err != nil {
+				err = fmt.Errorf("failed to decode account at address %s: %s", address.String(), err)
+				return true
+			}
+
+			current.Nonce = model.Nonce
+			if model.IsMultisig() {
+				current.MultisigData = &types.Multisig{
+					Weights:   model.MultisigData.Weights,
+					Threshold: model.MultisigData.Threshold,
+					Addresses: model.MultisigData.Addresses,
+				}
+			}
+
+			return false
+		}
+
+		if key[1+types.AddressLength] == balancePrefix {
+			var coin types.CoinSymbol
+			copy(coin[:], key[2+types.AddressLength:])
+			current.Balance = append(current.Balance, types.Balance{
+				Coin:  coin,
+				Value: big.NewInt(0).SetBytes(value).String(),
+			})
+		}
+
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if !stopped {
+		flush()
+	}
+
+	return nil
+}
+
 func (a *Accounts) GetAccount(address types.Address) *Model {
 	return a.getOrNew(address)
 }
@@ -0,0 +1,294 @@
+package state
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/tree"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+	"sync"
+)
+
+const (
+	// archiveQueueSize is a number of snapshots waiting to be copied to the archive
+	archiveQueueSize = 16
+
+	// archiveBatchSize is a number of keys saved to the archive at once while copying a snapshot
+	archiveBatchSize = 100000
+
+	// maxArchiveAttempts is a number of failed copies of a snapshot after which it is kept in the state database
+	maxArchiveAttempts = 3
+
+	archiveDonePrefix    = 'd'
+	archivePendingPrefix = 'p'
+	archiveTreePrefix    = 't'
+)
+
+type archiveItem struct {
+	height uint64
+	tree   *tree.ImmutableTree
+}
+
+// Archive keeps copies of snapshots of the state in a separate database, so they can be deleted from the state database.
+// Each snapshot is stored as a full copy of the IAVL tree under its own prefix, nodes are not shared between snapshots,
+// so every snapshot takes about as much space as the whole state. A snapshot becomes available when its copy is complete.
+//
+// Snapshots to be moved to the archive are recorded as pending in the archive database, so they survive restarts.
+// A pending snapshot is removed when it is deleted from the state database or its copy fails maxArchiveAttempts times.
+// Nil archive is valid, it has no snapshots.
+type Archive struct {
+	db     db.DB
+	logger log.Logger
+	queue  chan archiveItem
+
+	lock   sync.Mutex
+	queued map[uint64]bool // snapshots waiting in the queue or being copied
+}
+
+func NewArchive(db db.DB, logger log.Logger) *Archive {
+	return &Archive{
+		db:     db,
+		logger: logger,
+		queue:  make(chan archiveItem, archiveQueueSize),
+		queued: map[uint64]bool{},
+	}
+}
+
+// Run copies queued snapshots until the context is done
+func (a *Archive) Run(ctx context.Context) {
+	if a == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case item := <-a.queue:
+			a.save(item)
+		}
+	}
+}
+
+func (a *Archive) save(item archiveItem) {
+	defer func() {
+		a.lock.Lock()
+		delete(a.queued, item.height)
+		a.lock.Unlock()
+	}()
+
+	err := a.Save(item.height, item.tree)
+	if err == nil {
+		a.logger.Info("State archived", "height", item.height)
+		return
+	}
+
+	attempts := a.attempts(item.height) + 1
+	if attempts < maxArchiveAttempts {
+		a.logger.Error("Failed to archive state", "height", item.height, "attempt", attempts, "err", err)
+		if err := a.db.SetSync(archiveKey(archivePendingPrefix, item.height), uint64Bytes(attempts)); err != nil {
+			a.logger.Error("Failed to update pending state", "height", item.height, "err", err)
+		}
+		return
+	}
+
+	a.logger.Error("Failed to archive state, it is kept in the state database", "height", item.height, "err", err)
+	a.Remove(item.height)
+}
+
+// Add records the snapshot at the height as pending to be moved to the archive
+func (a *Archive) Add(height uint64) {
+	if a == nil {
+		return
+	}
+
+	if err := a.db.SetSync(archiveKey(archivePendingPrefix, height), uint64Bytes(0)); err != nil {
+		a.logger.Error("Failed to add pending state", "height", height, "err", err)
+	}
+}
+
+// Remove removes the pending record of the snapshot at the height
+func (a *Archive) Remove(height uint64) {
+	if a == nil {
+		return
+	}
+
+	if err := a.db.DeleteSync(archiveKey(archivePendingPrefix, height)); err != nil {
+		a.logger.Error("Failed to remove pending state", "height", height, "err", err)
+	}
+}
+
+// Pending returns heights of snapshots which are not moved to the archive yet
+func (a *Archive) Pending() []uint64 {
+	if a == nil {
+		return nil
+	}
+
+	prefix := []byte{archivePendingPrefix}
+	it, err := a.db.Iterator(prefix, []byte{archivePendingPrefix + 1})
+	if err != nil {
+		a.logger.Error("Failed to load pending states", "err", err)
+		return nil
+	}
+	defer it.Close()
+
+	var heights []uint64
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, binary.BigEndian.Uint64(it.Key()[1:]))
+	}
+
+	return heights
+}
+
+func (a *Archive) attempts(height uint64) uint64 {
+	value, err := a.db.Get(archiveKey(archivePendingPrefix, height))
+	if err != nil || len(value) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(value)
+}
+
+// IsQueued reports whether the snapshot at the height is waiting in the queue or being copied
+func (a *Archive) IsQueued(height uint64) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.queued[height]
+}
+
+// SaveAsync queues copying of the state at the height. The snapshot is skipped if the queue is full,
+// it stays pending and is queued again later.
+func (a *Archive) SaveAsync(height uint64, snapshot *tree.ImmutableTree) {
+	if a == nil {
+		return
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.queued[height] {
+		return
+	}
+
+	select {
+	case a.queue <- archiveItem{height: height, tree: snapshot}:
+		a.queued[height] = true
+	default:
+		a.logger.Info("Archive queue is full, state will be archived later", "height", height)
+	}
+}
+
+// Save copies the state at the height to the archive. The copy takes as much space as the whole state.
+func (a *Archive) Save(height uint64, snapshot *tree.ImmutableTree) error {
+	prefix := archiveKey(archiveTreePrefix, height)
+
+	// remove leftovers of interrupted copy
+	if err := deletePrefix(a.db, prefix); err != nil {
+		return err
+	}
+
+	archived := tree.NewMutableTree(db.NewPrefixDB(a.db, prefix), 1024)
+
+	var err error
+	count := 0
+	snapshot.Iterate(func(key []byte, value []byte) bool {
+		archived.Set(key, value)
+
+		count++
+		if count%archiveBatchSize == 0 {
+			_, _, err = archived.SaveVersion()
+		}
+
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// hash of the archived tree differs from the original one, since IAVL nodes are hashed with their versions
+	_, version, err := archived.SaveVersion()
+	if err != nil {
+		return err
+	}
+
+	// intermediate versions are not needed
+	for v := int64(1); v < version; v++ {
+		if err := archived.DeleteVersion(v); err != nil {
+			return err
+		}
+	}
+
+	return a.db.SetSync(archiveKey(archiveDonePrefix, height), uint64Bytes(uint64(version)))
+}
+
+// Has reports whether the state at the height is archived
+func (a *Archive) Has(height uint64) bool {
+	if a == nil {
+		return false
+	}
+
+	value, err := a.db.Get(archiveKey(archiveDonePrefix, height))
+	return err == nil && value != nil
+}
+
+// State returns immutable state at the height from the archive
+func (a *Archive) State(height uint64) (*State, error) {
+	if a == nil {
+		return nil, fmt.Errorf("state at height %d is not archived", height)
+	}
+
+	value, err := a.db.Get(archiveKey(archiveDonePrefix, height))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("state at height %d is not archived", height)
+	}
+
+	archived := tree.NewMutableTree(db.NewPrefixDB(a.db, archiveKey(archiveTreePrefix, height)), 1024)
+	if _, err := archived.LazyLoadVersion(int64(binary.BigEndian.Uint64(value))); err != nil {
+		return nil, err
+	}
+
+	return newStateForTree(archived.GetImmutable(), nil, nil, 0)
+}
+
+func archiveKey(prefix byte, height uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = prefix
+	binary.BigEndian.PutUint64(key[1:], height)
+
+	return key
+}
+
+func uint64Bytes(value uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, value)
+	return b
+}
+
+func deletePrefix(database db.DB, prefix []byte) error {
+	prefixDB := db.NewPrefixDB(database, prefix)
+
+	it, err := prefixDB.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+	}
+	it.Close()
+
+	batch := prefixDB.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+
+	return batch.Write()
+}
@@ -0,0 +1,176 @@
+package state
+
+import (
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	db "github.com/tendermint/tm-db"
+	"math/big"
+	"sort"
+)
+
+const (
+	minCrr = 10
+	maxCrr = 100
+)
+
+// Discrepancy is a violated invariant of the state
+type Discrepancy struct {
+	Check    string `json:"check"`
+	Subject  string `json:"subject"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// AuditReport is a result of the full audit of the state at the height
+type AuditReport struct {
+	Height        uint64        `json:"height"`
+	Hash          string        `json:"hash"`
+	Accounts      int           `json:"accounts"`
+	Coins         int           `json:"coins"`
+	Candidates    int           `json:"candidates"`
+	Stakes        int           `json:"stakes"`
+	FrozenFunds   int           `json:"frozen_funds"`
+	Discrepancies []Discrepancy `json:"discrepancies"`
+}
+
+// Ok returns true if no discrepancies are found
+func (r *AuditReport) Ok() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// CheckHash compares the root hash of the state with the hash recorded by the source, e.g. a block header
+func (r *AuditReport) CheckHash(source string, expected []byte) {
+	if actual := fmt.Sprintf("%X", expected); actual != r.Hash {
+		r.add(Discrepancy{Check: "root_hash", Subject: source, Expected: actual, Actual: r.Hash})
+	}
+}
+
+func (r *AuditReport) add(discrepancy Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, discrepancy)
+}
+
+// Audit iterates all accounts, stakes, frozen funds and coins of the committed state at the height.
+// It checks that the volume of each coin equals the sum of its balances, stakes, pending delegations and frozen funds,
+// that coins are within bounds of their bonding curves and that the total stake of each candidate equals
+// the sum of bip values of its stakes. Broken nodes of the tree are reported as well.
+func Audit(height uint64, db db.DB) (report *AuditReport, err error) {
+	s, err := NewCheckStateAtHeight(height, db)
+	if err != nil {
+		return nil, err
+	}
+
+	report = &AuditReport{Height: height, Hash: fmt.Sprintf("%X", s.tree.Hash()), Discrepancies: []Discrepancy{}}
+
+	// missing or corrupted nodes of the tree cause panics during iteration
+	defer func() {
+		if r := recover(); r != nil {
+			report.add(Discrepancy{Check: "tree", Subject: report.Hash, Message: fmt.Sprintf("%v", r)})
+		}
+	}()
+
+	volumes := map[types.CoinSymbol]*big.Int{}
+	addVolume := func(coin types.CoinSymbol, value *big.Int) {
+		if volumes[coin] == nil {
+			volumes[coin] = big.NewInt(0)
+		}
+		volumes[coin].Add(volumes[coin], value)
+	}
+
+	if err := s.Accounts.IterateAccounts(nil, func(account types.Account, next []byte) bool {
+		report.Accounts++
+		for _, balance := range account.Balance {
+			addVolume(balance.Coin, helpers.StringToBigInt(balance.Value))
+		}
+
+		return false
+	}); err != nil {
+		report.add(Discrepancy{Check: "accounts", Message: err.Error()})
+	}
+
+	s.Candidates.LoadCandidates()
+	s.Candidates.LoadStakes()
+	for _, candidate := range s.Candidates.GetCandidates() {
+		report.Candidates++
+
+		totalBipStake := big.NewInt(0)
+		for _, stake := range s.Candidates.GetStakes(candidate.PubKey) {
+			report.Stakes++
+			addVolume(stake.Coin, stake.Value)
+			totalBipStake.Add(totalBipStake, stake.BipValue)
+		}
+
+		for _, update := range s.Candidates.GetUpdates(candidate.PubKey) {
+			addVolume(update.Coin, update.Value)
+		}
+
+		if actual := candidate.GetTotalBipStake(); actual.Cmp(totalBipStake) != 0 {
+			report.add(Discrepancy{
+				Check:    "candidate_total_stake",
+				Subject:  candidate.PubKey.String(),
+				Expected: totalBipStake.String(),
+				Actual:   actual.String(),
+			})
+		}
+	}
+
+	if err := s.FrozenFunds.IterateFrozenFunds(nil, func(fund types.FrozenFund, next []byte) bool {
+		report.FrozenFunds++
+		addVolume(fund.Coin, helpers.StringToBigInt(fund.Value))
+
+		return false
+	}); err != nil {
+		report.add(Discrepancy{Check: "frozen_funds", Message: err.Error()})
+	}
+
+	symbols := map[types.CoinSymbol]struct{}{}
+	for _, symbol := range s.Coins.Symbols() {
+		report.Coins++
+		symbols[symbol] = struct{}{}
+
+		coin := s.Coins.GetCoin(symbol)
+		volume := volumes[symbol]
+		if volume == nil {
+			volume = big.NewInt(0)
+		}
+
+		if volume.Cmp(coin.Volume()) != 0 {
+			report.add(Discrepancy{Check: "coin_volume", Subject: symbol.String(), Expected: volume.String(), Actual: coin.Volume().String()})
+		}
+
+		if coin.Crr() < minCrr || coin.Crr() > maxCrr {
+			report.add(Discrepancy{Check: "coin_crr", Subject: symbol.String(), Actual: fmt.Sprintf("%d", coin.Crr()),
+				Message: fmt.Sprintf("crr should be between %d and %d", minCrr, maxCrr)})
+		}
+
+		if coin.MaxSupply() != nil && coin.Volume().Cmp(coin.MaxSupply()) == 1 {
+			report.add(Discrepancy{Check: "coin_max_supply", Subject: symbol.String(), Expected: coin.MaxSupply().String(),
+				Actual: coin.Volume().String(), Message: "volume exceeds max supply"})
+		}
+
+		if coin.Volume().Sign() == 1 && coin.Reserve().Sign() != 1 {
+			report.add(Discrepancy{Check: "coin_reserve", Subject: symbol.String(), Actual: coin.Reserve().String(),
+				Message: "coin with positive volume has no reserve"})
+		} else if err := coin.CheckReserveUnderflow(big.NewInt(0)); err != nil {
+			// slashing of stakes may reduce reserves without this check, so such coins are reported too
+			report.add(Discrepancy{Check: "coin_reserve", Subject: symbol.String(), Actual: coin.Reserve().String(), Message: err.Error()})
+		}
+	}
+
+	var unknown []types.CoinSymbol
+	for symbol, volume := range volumes {
+		if _, exists := symbols[symbol]; !exists && !symbol.IsBaseCoin() && volume.Sign() != 0 {
+			unknown = append(unknown, symbol)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool {
+		return unknown[i].Compare(unknown[j]) == -1
+	})
+
+	for _, symbol := range unknown {
+		report.add(Discrepancy{Check: "coin_exists", Subject: symbol.String(), Actual: volumes[symbol].String(), Message: "coin is not found"})
+	}
+
+	return report, nil
+}
@@ -0,0 +1,73 @@
+package state
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"math/big"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	st := getState()
+
+	coin := types.StrToCoinSymbol("TEST")
+	st.Coins.Create(coin, "TEST", big.NewInt(100), 50, helpers.BipToPip(big.NewInt(20000)), helpers.BipToPip(big.NewInt(1000000)))
+	st.Accounts.AddBalance(types.Address{1}, coin, big.NewInt(60))
+
+	pubkey := createTestCandidate(st)
+	st.Candidates.Delegate(types.Address{2}, pubkey, coin, big.NewInt(30), big.NewInt(0))
+	st.FrozenFunds.AddFund(height, types.Address{3}, pubkey, coin, big.NewInt(10))
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Audit(1, st.db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.Ok() {
+		t.Fatalf("Unexpected discrepancies: %+v", report.Discrepancies)
+	}
+
+	if report.Coins != 1 || report.Candidates != 1 || report.FrozenFunds != 1 || report.Accounts == 0 {
+		t.Fatalf("Wrong counters of the report: %+v", report)
+	}
+
+	st.Coins.AddVolume(coin, big.NewInt(5))
+	st.Candidates.SetTotalStake(pubkey, big.NewInt(7))
+	hash, err := st.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = Audit(2, st.db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report.CheckHash("block", hash)
+	report.CheckHash("other", []byte{1})
+
+	if len(report.Discrepancies) != 3 {
+		t.Fatalf("Expected 3 discrepancies, got %+v", report.Discrepancies)
+	}
+
+	volume, stake, rootHash := report.Discrepancies[1], report.Discrepancies[0], report.Discrepancies[2]
+	if volume.Check != "coin_volume" || volume.Expected != "100" || volume.Actual != "105" {
+		t.Errorf("Wrong volume discrepancy: %+v", volume)
+	}
+
+	if stake.Check != "candidate_total_stake" || stake.Expected != "0" || stake.Actual != "7" {
+		t.Errorf("Wrong total stake discrepancy: %+v", stake)
+	}
+
+	if rootHash.Check != "root_hash" || rootHash.Subject != "other" {
+		t.Errorf("Wrong root hash discrepancy: %+v", rootHash)
+	}
+
+	if _, err := Audit(10, st.db); err == nil {
+		t.Error("Expected error for missing version")
+	}
+}
@@ -22,16 +22,33 @@ const (
 
 	UnbondPeriod              = 518400
 	MaxDelegatorsPerCandidate = 1000
-
-	mainPrefix       = 'c'
-	stakesPrefix     = 's'
-	totalStakePrefix = 't'
-	updatesPrefix    = 'u'
+	CommissionUpdateDelay     = 120960
+
+	mainPrefix             = 'c'
+	stakesPrefix           = 's'
+	totalStakePrefix       = 't'
+	updatesPrefix          = 'u'
+	commissionUpdatePrefix = 'm'
+	delegationPolicyPrefix = 'p'
+	dataPrefix             = 'd'
+	indexPrefix            = 'i'
+	pubKeyChangesPrefix    = 'h'
 )
 
 type Candidates struct {
 	list map[types.Pubkey]*Candidate
 
+	// public keys which were changed and whose data should be removed from the tree
+	deleted []types.Pubkey
+
+	// pubKeyChanges are replaced public keys by their Tendermint addresses, nil values cache absent keys
+	pubKeyChanges map[types.TmAddress]*PubKeyChange
+
+	// split is true if candidates are stored under their own keys with the index of public keys
+	// instead of the single list, see MigrateToSplitKeys
+	split        bool
+	isIndexDirty bool
+
 	iavl tree.Tree
 	bus  *bus.Bus
 
@@ -40,35 +57,30 @@ type Candidates struct {
 }
 
 func NewCandidates(bus *bus.Bus, iavl tree.Tree) (*Candidates, error) {
-	candidates := &Candidates{iavl: iavl, bus: bus}
+	candidates := &Candidates{iavl: iavl, bus: bus, pubKeyChanges: map[types.TmAddress]*PubKeyChange{}}
 	candidates.bus.SetCandidates(NewBus(candidates))
 
 	return candidates, nil
 }
 
 func (c *Candidates) Commit() error {
-	keys := c.getOrderedCandidates()
+	for _, pubkey := range c.deleted {
+		c.removeFromTree(pubkey)
+	}
+	c.deleted = nil
 
-	hasDirty := false
-	for _, pubkey := range keys {
-		if c.getFromMap(pubkey).isDirty {
-			hasDirty = true
-			break
-		}
+	if err := c.commitPubKeyChanges(); err != nil {
+		return err
 	}
 
-	if hasDirty {
-		var candidates []*Candidate
-		for _, key := range keys {
-			candidates = append(candidates, c.getFromMap(key))
-		}
-		data, err := rlp.EncodeToBytes(candidates)
-		if err != nil {
-			return fmt.Errorf("can't encode candidates: %v", err)
-		}
+	keys := c.getOrderedCandidates()
 
-		path := []byte{mainPrefix}
-		c.iavl.Set(path, data)
+	if c.split {
+		if err := c.commitCandidates(keys); err != nil {
+			return err
+		}
+	} else if err := c.commitList(keys); err != nil {
+		return err
 	}
 
 	for _, pubkey := range keys {
@@ -121,11 +133,175 @@ func (c *Candidates) Commit() error {
 			c.iavl.Set(path, data)
 			candidate.isUpdatesDirty = false
 		}
+
+		if candidate.isCommissionUpdateDirty {
+			path := []byte{mainPrefix}
+			path = append(path, pubkey[:]...)
+			path = append(path, commissionUpdatePrefix)
+			candidate.isCommissionUpdateDirty = false
+
+			if candidate.commissionUpdate == nil {
+				c.iavl.Remove(path)
+			} else {
+				data, err := rlp.EncodeToBytes(candidate.commissionUpdate)
+				if err != nil {
+					return fmt.Errorf("can't encode candidate commission update: %v", err)
+				}
+
+				c.iavl.Set(path, data)
+			}
+		}
+
+		if candidate.isDelegationPolicyDirty {
+			path := []byte{mainPrefix}
+			path = append(path, pubkey[:]...)
+			path = append(path, delegationPolicyPrefix)
+			candidate.isDelegationPolicyDirty = false
+
+			if candidate.delegationPolicy == nil {
+				c.iavl.Remove(path)
+			} else {
+				data, err := rlp.EncodeToBytes(candidate.delegationPolicy)
+				if err != nil {
+					return fmt.Errorf("can't encode candidate delegation policy: %v", err)
+				}
+
+				c.iavl.Set(path, data)
+			}
+		}
 	}
 
 	return nil
 }
 
+// commitList writes all candidates under the single key if any of them is changed
+func (c *Candidates) commitList(keys []types.Pubkey) error {
+	hasDirty := false
+	for _, pubkey := range keys {
+		if c.getFromMap(pubkey).isDirty {
+			hasDirty = true
+			break
+		}
+	}
+
+	if !hasDirty {
+		return nil
+	}
+
+	var candidates []*Candidate
+	for _, key := range keys {
+		candidates = append(candidates, c.getFromMap(key))
+	}
+	data, err := rlp.EncodeToBytes(candidates)
+	if err != nil {
+		return fmt.Errorf("can't encode candidates: %v", err)
+	}
+
+	path := []byte{mainPrefix}
+	c.iavl.Set(path, data)
+
+	return nil
+}
+
+// commitCandidates writes changed candidates under their own keys and the index if the set of candidates is changed
+func (c *Candidates) commitCandidates(keys []types.Pubkey) error {
+	if c.isIndexDirty {
+		data, err := rlp.EncodeToBytes(keys)
+		if err != nil {
+			return fmt.Errorf("can't encode candidates index: %v", err)
+		}
+
+		c.iavl.Set([]byte{mainPrefix, indexPrefix}, data)
+		c.isIndexDirty = false
+	}
+
+	for _, pubkey := range keys {
+		candidate := c.getFromMap(pubkey)
+		if !candidate.isDirty {
+			continue
+		}
+
+		data, err := rlp.EncodeToBytes(candidate)
+		if err != nil {
+			return fmt.Errorf("can't encode candidate: %v", err)
+		}
+
+		path := []byte{mainPrefix}
+		path = append(path, pubkey[:]...)
+		path = append(path, dataPrefix)
+		c.iavl.Set(path, data)
+	}
+
+	return nil
+}
+
+// commitPubKeyChanges writes public keys replaced in the current block
+func (c *Candidates) commitPubKeyChanges() error {
+	var addresses []types.TmAddress
+	for address, change := range c.pubKeyChanges {
+		if change != nil && change.isDirty {
+			addresses = append(addresses, address)
+		}
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) == -1
+	})
+
+	for _, address := range addresses {
+		change := c.pubKeyChanges[address]
+		change.isDirty = false
+
+		data, err := rlp.EncodeToBytes(change)
+		if err != nil {
+			return fmt.Errorf("can't encode public key change: %v", err)
+		}
+
+		c.iavl.Set(getPubKeyChangePath(address), data)
+	}
+
+	return nil
+}
+
+// MigrateToSplitKeys moves candidates from the single list to their own keys, so changes of a candidate
+// don't rewrite the others. Candidates are written on the next commit. It does nothing if candidates
+// are already stored under their own keys, so it is called on every block after the upgrade.
+func (c *Candidates) MigrateToSplitKeys() {
+	c.LoadCandidates()
+	if c.split {
+		return
+	}
+
+	c.split = true
+	c.isIndexDirty = true
+	for _, pubkey := range c.getOrderedCandidates() {
+		c.getFromMap(pubkey).isDirty = true
+	}
+
+	c.iavl.Remove([]byte{mainPrefix})
+}
+
+func getPubKeyChangePath(address types.TmAddress) []byte {
+	return append([]byte{mainPrefix, pubKeyChangesPrefix}, address[:]...)
+}
+
+func (c *Candidates) removeFromTree(pubkey types.Pubkey) {
+	for _, prefix := range []byte{dataPrefix, totalStakePrefix, updatesPrefix, commissionUpdatePrefix, delegationPolicyPrefix} {
+		path := []byte{mainPrefix}
+		path = append(path, pubkey[:]...)
+		path = append(path, prefix)
+		c.iavl.Remove(path)
+	}
+
+	for index := 0; index < MaxDelegatorsPerCandidate; index++ {
+		path := []byte{mainPrefix}
+		path = append(path, pubkey[:]...)
+		path = append(path, stakesPrefix)
+		path = append(path, []byte(fmt.Sprintf("%d", index))...)
+		c.iavl.Remove(path)
+	}
+}
+
 func (c *Candidates) GetNewCandidates(valCount int) []Candidate {
 	var result []Candidate
 
@@ -164,10 +340,12 @@ func (c *Candidates) Create(ownerAddress types.Address, rewardAddress types.Addr
 		stakes:            [MaxDelegatorsPerCandidate]*Stake{},
 		isDirty:           true,
 		isTotalStakeDirty: true,
+		stakesLoaded:      true,
 	}
 	candidate.setTmAddress()
 
 	c.setToMap(pubkey, candidate)
+	c.isIndexDirty = true
 }
 
 func (c *Candidates) PunishByzantineCandidate(height uint64, tmAddress types.TmAddress) {
@@ -596,6 +774,136 @@ func (c *Candidates) Edit(pubkey types.Pubkey, rewardAddress types.Address, owne
 	candidate.setReward(rewardAddress)
 }
 
+// SetCommissionUpdate schedules candidate's commission to be changed at given height
+func (c *Candidates) SetCommissionUpdate(pubkey types.Pubkey, commission uint, height uint64) {
+	candidate := c.getFromMap(pubkey)
+	candidate.setCommissionUpdate(&CommissionUpdate{
+		Commission: commission,
+		Height:     height,
+	})
+}
+
+// ApplyCommissionUpdates sets new commissions of candidates whose updates are due at given height
+func (c *Candidates) ApplyCommissionUpdates(height uint64) {
+	for _, candidate := range c.GetCandidates() {
+		update := candidate.commissionUpdate
+		if update == nil || update.Height > height {
+			continue
+		}
+
+		candidate.setCommission(update.Commission)
+		candidate.setCommissionUpdate(nil)
+	}
+}
+
+// ChangePubKey moves candidate with its stakes, updates and scheduled commission change to a new public key.
+// Stakes of the candidate should be loaded.
+func (c *Candidates) ChangePubKey(old types.Pubkey, new types.Pubkey) {
+	candidate := c.getFromMap(old)
+
+	c.lock.Lock()
+	delete(c.list, old)
+	c.lock.Unlock()
+
+	c.deleted = append(c.deleted, old)
+	c.isIndexDirty = true
+
+	candidate.PubKey = new
+	candidate.setTmAddress()
+
+	candidate.isDirty = true
+	candidate.isTotalStakeDirty = true
+	if len(candidate.updates) != 0 {
+		candidate.isUpdatesDirty = true
+	}
+	if candidate.commissionUpdate != nil {
+		candidate.isCommissionUpdateDirty = true
+	}
+	if candidate.delegationPolicy != nil {
+		candidate.isDelegationPolicyDirty = true
+	}
+	for index, stake := range candidate.stakes {
+		if stake != nil {
+			candidate.dirtyStakes[index] = true
+		}
+	}
+
+	c.setToMap(new, candidate)
+	c.setPubKeyChange(old, new)
+}
+
+// BlockPubKey forbids candidates to use the public key, e.g. a key from the block list of genesis
+func (c *Candidates) BlockPubKey(pubkey types.Pubkey) {
+	c.setPubKeyChange(pubkey, types.Pubkey{})
+}
+
+// ImportPubKeyChange records replacement of the public key imported from genesis, so the old key is blocked
+// and votes of the old key are resolved to the new one
+func (c *Candidates) ImportPubKeyChange(old types.Pubkey, new types.Pubkey) {
+	c.setPubKeyChange(old, new)
+}
+
+// setPubKeyChange records that the public key is replaced by the new one, so the old key can't be used by
+// candidates anymore. Empty new key only blocks the old one.
+func (c *Candidates) setPubKeyChange(old types.Pubkey, new types.Pubkey) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pubKeyChanges[getTmAddress(old)] = &PubKeyChange{Old: old, New: new, isDirty: true}
+}
+
+func (c *Candidates) getPubKeyChange(address types.TmAddress) *PubKeyChange {
+	c.lock.RLock()
+	change, ok := c.pubKeyChanges[address]
+	c.lock.RUnlock()
+
+	if ok {
+		return change
+	}
+
+	if _, enc := c.iavl.Get(getPubKeyChangePath(address)); len(enc) != 0 {
+		change = &PubKeyChange{}
+		if err := rlp.DecodeBytes(enc, change); err != nil {
+			panic(fmt.Sprintf("failed to decode public key change: %s", err))
+		}
+	}
+
+	c.lock.Lock()
+	c.pubKeyChanges[address] = change
+	c.lock.Unlock()
+
+	return change
+}
+
+// IsBlockedPubKey reports whether the public key was replaced by EditCandidatePublicKey and can't be used again
+func (c *Candidates) IsBlockedPubKey(pubkey types.Pubkey) bool {
+	return c.getPubKeyChange(getTmAddress(pubkey)) != nil
+}
+
+// CurrentTmAddress resolves Tendermint address of a replaced public key to the address of the current key
+// of the candidate. Other addresses are returned as is. Tendermint applies new keys of validators with a delay,
+// so votes and evidences of a validator may refer to its old key.
+func (c *Candidates) CurrentTmAddress(address types.TmAddress) types.TmAddress {
+	for {
+		change := c.getPubKeyChange(address)
+		if change == nil || change.New == (types.Pubkey{}) {
+			return address
+		}
+
+		address = getTmAddress(change.New)
+	}
+}
+
+// SetDelegationPolicy sets delegation policy of the candidate. Empty policy removes all restrictions.
+func (c *Candidates) SetDelegationPolicy(pubkey types.Pubkey, policy *DelegationPolicy) {
+	candidate := c.getFromMap(pubkey)
+	if policy != nil && policy.IsEmpty() {
+		policy = nil
+	}
+
+	candidate.setDelegationPolicy(policy)
+}
+
 func (c *Candidates) SetOnline(pubkey types.Pubkey) {
 	c.getFromMap(pubkey).setStatus(CandidateStatusOnline)
 }
@@ -652,10 +960,46 @@ func (c *Candidates) GetStakes(pubkey types.Pubkey) []*Stake {
 	return stakes
 }
 
+// GetUpdates returns delegations to the candidate which are not applied to its stakes yet
+func (c *Candidates) GetUpdates(pubkey types.Pubkey) []*Stake {
+	return c.GetCandidate(pubkey).updates
+}
+
 func (c *Candidates) StakesCount(pubkey types.Pubkey) int {
 	return c.GetCandidate(pubkey).stakesCount
 }
 
+// IterateStakes calls fn for committed stakes in order of public keys of candidates starting from the cursor,
+// nil cursor means the first stake. Cursor passed to fn points to the next stake.
+// Delegations which are not applied to stakes yet are not visited. Iteration stops when fn returns true.
+func (c *Candidates) IterateStakes(cursor []byte, fn func(pubkey types.Pubkey, stake *Stake, next []byte) bool) error {
+	start := []byte{mainPrefix}
+	if cursor != nil {
+		if len(cursor) == 0 || cursor[0] != mainPrefix {
+			return fmt.Errorf("invalid cursor %x", cursor)
+		}
+		start = cursor
+	}
+
+	var err error
+	c.iavl.IterateRange(start, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) <= 2+types.PubKeyLength || key[1+types.PubKeyLength] != stakesPrefix {
+			return false
+		}
+
+		stake := &Stake{}
+		if err = rlp.DecodeBytes(value, stake); err != nil {
+			err = fmt.Errorf("failed to decode stake: %s", err)
+			return true
+		}
+
+		next := append(append([]byte{}, key...), 0)
+		return fn(types.BytesToPubkey(key[1:1+types.PubKeyLength]), stake, next)
+	})
+
+	return err
+}
+
 func (c *Candidates) GetStakeOfAddress(pubkey types.Pubkey, address types.Address, coin types.CoinSymbol) *Stake {
 	candidate := c.GetCandidate(pubkey)
 	for _, stake := range candidate.stakes {
@@ -690,35 +1034,91 @@ func (c *Candidates) LoadCandidates() {
 	}
 	c.loaded = true
 
-	path := []byte{mainPrefix}
-	_, enc := c.iavl.Get(path)
-	if len(enc) == 0 {
-		c.list = map[types.Pubkey]*Candidate{}
-		return
-	}
-
-	var candidates []*Candidate
-	if err := rlp.DecodeBytes(enc, &candidates); err != nil {
-		panic(fmt.Sprintf("failed to decode candidates: %s", err))
-	}
+	candidates := c.loadList()
 
 	c.list = map[types.Pubkey]*Candidate{}
 	for _, candidate := range candidates {
 		// load total stake
-		path = append([]byte{mainPrefix}, candidate.PubKey.Bytes()...)
+		path := append([]byte{mainPrefix}, candidate.PubKey.Bytes()...)
 		path = append(path, totalStakePrefix)
-		_, enc = c.iavl.Get(path)
+		_, enc := c.iavl.Get(path)
 		if len(enc) == 0 {
 			candidate.totalBipStake = big.NewInt(0)
 		} else {
 			candidate.totalBipStake = big.NewInt(0).SetBytes(enc)
 		}
 
+		// load commission update
+		path = append([]byte{mainPrefix}, candidate.PubKey.Bytes()...)
+		path = append(path, commissionUpdatePrefix)
+		_, enc = c.iavl.Get(path)
+		if len(enc) != 0 {
+			update := &CommissionUpdate{}
+			if err := rlp.DecodeBytes(enc, update); err != nil {
+				panic(fmt.Sprintf("failed to decode candidate commission update: %s", err))
+			}
+			candidate.commissionUpdate = update
+		}
+
+		// load delegation policy
+		path = append([]byte{mainPrefix}, candidate.PubKey.Bytes()...)
+		path = append(path, delegationPolicyPrefix)
+		_, enc = c.iavl.Get(path)
+		if len(enc) != 0 {
+			policy := &DelegationPolicy{}
+			if err := rlp.DecodeBytes(enc, policy); err != nil {
+				panic(fmt.Sprintf("failed to decode candidate delegation policy: %s", err))
+			}
+			candidate.delegationPolicy = policy
+		}
+
 		candidate.setTmAddress()
 		c.setToMap(candidate.PubKey, candidate)
 	}
 }
 
+// loadList reads candidates from their own keys listed in the index or from the single list written before the migration
+func (c *Candidates) loadList() []*Candidate {
+	_, enc := c.iavl.Get([]byte{mainPrefix, indexPrefix})
+	if len(enc) == 0 {
+		_, enc = c.iavl.Get([]byte{mainPrefix})
+		if len(enc) == 0 {
+			return nil
+		}
+
+		var candidates []*Candidate
+		if err := rlp.DecodeBytes(enc, &candidates); err != nil {
+			panic(fmt.Sprintf("failed to decode candidates: %s", err))
+		}
+
+		return candidates
+	}
+
+	c.split = true
+
+	var pubkeys []types.Pubkey
+	if err := rlp.DecodeBytes(enc, &pubkeys); err != nil {
+		panic(fmt.Sprintf("failed to decode candidates index: %s", err))
+	}
+
+	candidates := make([]*Candidate, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		path := []byte{mainPrefix}
+		path = append(path, pubkey[:]...)
+		path = append(path, dataPrefix)
+		_, enc := c.iavl.Get(path)
+
+		candidate := &Candidate{}
+		if err := rlp.DecodeBytes(enc, candidate); err != nil {
+			panic(fmt.Sprintf("failed to decode candidate %s: %s", pubkey.String(), err))
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates
+}
+
 func (c *Candidates) LoadStakes() {
 	for pubkey := range c.list {
 		c.LoadStakesOfCandidate(pubkey)
@@ -852,17 +1252,82 @@ func (c *Candidates) Export(state *types.AppState) {
 			})
 		}
 
+		var commissionUpdate *types.CommissionUpdate
+		if update := candidate.GetCommissionUpdate(); update != nil {
+			commissionUpdate = &types.CommissionUpdate{
+				Commission: update.Commission,
+				Height:     update.Height,
+			}
+		}
+
+		var delegationPolicy *types.DelegationPolicy
+		if policy := candidate.GetDelegationPolicy(); policy != nil {
+			delegationPolicy = &types.DelegationPolicy{
+				MinStake:     policy.MinStake.String(),
+				AllowedCoins: policy.AllowedCoins,
+				Private:      policy.Private,
+				Whitelist:    policy.Whitelist,
+			}
+		}
+
 		state.Candidates = append(state.Candidates, types.Candidate{
-			RewardAddress: candidate.RewardAddress,
-			OwnerAddress:  candidate.OwnerAddress,
-			TotalBipStake: candidate.GetTotalBipStake().String(),
-			PubKey:        candidate.PubKey,
-			Commission:    candidate.Commission,
-			Stakes:        stakes,
-			Status:        candidate.Status,
+			RewardAddress:    candidate.RewardAddress,
+			OwnerAddress:     candidate.OwnerAddress,
+			TotalBipStake:    candidate.GetTotalBipStake().String(),
+			PubKey:           candidate.PubKey,
+			Commission:       candidate.Commission,
+			CommissionUpdate: commissionUpdate,
+			DelegationPolicy: delegationPolicy,
+			Stakes:           stakes,
+			Status:           candidate.Status,
 		})
 	}
 
+	start := []byte{mainPrefix, pubKeyChangesPrefix}
+	c.iavl.IterateRange(start, []byte{mainPrefix, pubKeyChangesPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) != len(start)+len(types.TmAddress{}) {
+			return false
+		}
+
+		change := &PubKeyChange{}
+		if err := rlp.DecodeBytes(value, change); err != nil {
+			panic(fmt.Sprintf("failed to decode public key change: %s", err))
+		}
+
+		if change.New == (types.Pubkey{}) {
+			state.BlockListCandidates = append(state.BlockListCandidates, change.Old)
+		} else {
+			state.PubKeyChanges = append(state.PubKeyChanges, types.PubKeyChange{Old: change.Old, New: change.New})
+		}
+		return false
+	})
+}
+
+// DirtyTotalStakes returns total stakes of candidates which were changed since the last commit.
+// It should be called before Commit, which resets dirty flags.
+func (c *Candidates) DirtyTotalStakes() map[types.Pubkey]*big.Int {
+	result := map[types.Pubkey]*big.Int{}
+	for _, pubkey := range c.getOrderedCandidates() {
+		candidate := c.getFromMap(pubkey)
+		if candidate.isTotalStakeDirty && candidate.totalBipStake != nil {
+			result[pubkey] = candidate.GetTotalBipStake()
+		}
+	}
+
+	return result
+}
+
+// DirtyCandidates returns public keys of candidates which were changed or removed since the last commit.
+// It should be called before Commit, which resets dirty flags.
+func (c *Candidates) DirtyCandidates() []types.Pubkey {
+	result := append([]types.Pubkey{}, c.deleted...)
+	for _, pubkey := range c.getOrderedCandidates() {
+		if c.getFromMap(pubkey).isChanged() {
+			result = append(result, pubkey)
+		}
+	}
+
+	return result
 }
 
 func (c *Candidates) getOrderedCandidates() []types.Pubkey {
@@ -899,8 +1364,14 @@ func (c *Candidates) SetTotalStake(pubkey types.Pubkey, stake *big.Int) {
 	c.GetCandidate(pubkey).setTotalBipStake(stake)
 }
 
+// LoadStakesOfCandidate loads stakes and updates of the candidate. Stakes are loaded only once,
+// so states shared by API readers don't reload them on every request.
 func (c *Candidates) LoadStakesOfCandidate(pubkey types.Pubkey) {
 	candidate := c.GetCandidate(pubkey)
+	if candidate == nil || candidate.stakesLoaded {
+		return
+	}
+	candidate.stakesLoaded = true
 
 	// load stakes
 	stakesCount := 0
@@ -19,11 +19,23 @@ type Candidate struct {
 	stakes        [MaxDelegatorsPerCandidate]*Stake
 	updates       []*Stake
 	tmAddress     *types.TmAddress
+	stakesLoaded  bool
 
-	isDirty           bool
-	isTotalStakeDirty bool
-	isUpdatesDirty    bool
-	dirtyStakes       [MaxDelegatorsPerCandidate]bool
+	commissionUpdate *CommissionUpdate
+	delegationPolicy *DelegationPolicy
+
+	isDirty                 bool
+	isTotalStakeDirty       bool
+	isUpdatesDirty          bool
+	isCommissionUpdateDirty bool
+	isDelegationPolicyDirty bool
+	dirtyStakes             [MaxDelegatorsPerCandidate]bool
+}
+
+// CommissionUpdate is a scheduled change of candidate's commission which takes effect at Height
+type CommissionUpdate struct {
+	Commission uint
+	Height     uint64
 }
 
 func (candidate *Candidate) setStatus(status byte) {
@@ -41,6 +53,88 @@ func (candidate *Candidate) setReward(address types.Address) {
 	candidate.RewardAddress = address
 }
 
+// DelegationPolicy restricts delegations to the candidate. Owner of the candidate is not restricted by
+// the whitelist. Policy applies only to new delegations.
+type DelegationPolicy struct {
+	MinStake     *big.Int
+	AllowedCoins []types.CoinSymbol
+	Private      bool
+	Whitelist    []types.Address
+}
+
+// IsEmpty reports whether the policy has no restrictions
+func (policy *DelegationPolicy) IsEmpty() bool {
+	return (policy.MinStake == nil || policy.MinStake.Sign() == 0) && len(policy.AllowedCoins) == 0 && !policy.Private && len(policy.Whitelist) == 0
+}
+
+func (policy *DelegationPolicy) IsCoinAllowed(coin types.CoinSymbol) bool {
+	if len(policy.AllowedCoins) == 0 {
+		return true
+	}
+
+	for _, allowed := range policy.AllowedCoins {
+		if allowed == coin {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (policy *DelegationPolicy) IsWhitelisted(address types.Address) bool {
+	for _, item := range policy.Whitelist {
+		if item == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (candidate *Candidate) setDelegationPolicy(policy *DelegationPolicy) {
+	candidate.isDelegationPolicyDirty = true
+	candidate.delegationPolicy = policy
+}
+
+// GetDelegationPolicy returns delegation policy of the candidate or nil if there is none
+func (candidate *Candidate) GetDelegationPolicy() *DelegationPolicy {
+	if candidate.delegationPolicy == nil {
+		return nil
+	}
+
+	policy := &DelegationPolicy{
+		MinStake:     big.NewInt(0),
+		AllowedCoins: append([]types.CoinSymbol(nil), candidate.delegationPolicy.AllowedCoins...),
+		Private:      candidate.delegationPolicy.Private,
+		Whitelist:    append([]types.Address(nil), candidate.delegationPolicy.Whitelist...),
+	}
+	if candidate.delegationPolicy.MinStake != nil {
+		policy.MinStake.Set(candidate.delegationPolicy.MinStake)
+	}
+
+	return policy
+}
+
+func (candidate *Candidate) setCommission(commission uint) {
+	candidate.isDirty = true
+	candidate.Commission = commission
+}
+
+func (candidate *Candidate) setCommissionUpdate(update *CommissionUpdate) {
+	candidate.isCommissionUpdateDirty = true
+	candidate.commissionUpdate = update
+}
+
+// GetCommissionUpdate returns pending commission change or nil if there is none
+func (candidate *Candidate) GetCommissionUpdate() *CommissionUpdate {
+	if candidate.commissionUpdate == nil {
+		return nil
+	}
+
+	update := *candidate.commissionUpdate
+	return &update
+}
+
 func (candidate *Candidate) addUpdate(stake *Stake) {
 	candidate.isUpdatesDirty = true
 	stake.markDirty = func(i int) {
@@ -70,14 +164,26 @@ func (candidate *Candidate) GetTmAddress() types.TmAddress {
 }
 
 func (candidate *Candidate) setTmAddress() {
-	// set tm address
+	address := getTmAddress(candidate.PubKey)
+	candidate.tmAddress = &address
+}
+
+func getTmAddress(publicKey types.Pubkey) types.TmAddress {
 	var pubkey ed25519.PubKeyEd25519
-	copy(pubkey[:], candidate.PubKey[:])
+	copy(pubkey[:], publicKey[:])
 
 	var address types.TmAddress
 	copy(address[:], pubkey.Address().Bytes())
 
-	candidate.tmAddress = &address
+	return address
+}
+
+// PubKeyChange is a public key of the candidate replaced by EditCandidatePublicKey
+type PubKeyChange struct {
+	Old types.Pubkey
+	New types.Pubkey
+
+	isDirty bool
 }
 
 func (candidate *Candidate) GetFilteredUpdates() []*Stake {
@@ -147,6 +253,22 @@ func (candidate *Candidate) updateStakesCount() {
 	candidate.stakesCount = count
 }
 
+// isChanged returns true if any data of the candidate should be written on commit
+func (candidate *Candidate) isChanged() bool {
+	if candidate.isDirty || candidate.isTotalStakeDirty || candidate.isUpdatesDirty ||
+		candidate.isCommissionUpdateDirty || candidate.isDelegationPolicyDirty {
+		return true
+	}
+
+	for _, dirty := range candidate.dirtyStakes {
+		if dirty {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (candidate *Candidate) GetTotalBipStake() *big.Int {
 	return big.NewInt(0).Set(candidate.totalBipStake)
 }
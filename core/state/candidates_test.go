@@ -1,6 +1,7 @@
 package state
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/binary"
 	eventsdb "github.com/MinterTeam/events-db"
@@ -389,6 +390,216 @@ func TestDelegationAfterUnbond(t *testing.T) {
 
 }
 
+func TestCommissionUpdate(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkey := createTestCandidate(st)
+	st.Candidates.SetCommissionUpdate(pubkey, 5, 100)
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := NewState(1, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := loaded.Candidates.GetCandidate(pubkey).GetCommissionUpdate()
+	if update == nil || update.Commission != 5 || update.Height != 100 {
+		t.Fatalf("Commission update is not loaded correctly: %+v", update)
+	}
+
+	loaded.Candidates.ApplyCommissionUpdates(100)
+	if _, err := loaded.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err = NewState(2, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	candidate := loaded.Candidates.GetCandidate(pubkey)
+	if candidate.Commission != 5 {
+		t.Fatalf("Commission should be 5, got %d", candidate.Commission)
+	}
+
+	if candidate.GetCommissionUpdate() != nil {
+		t.Fatalf("Commission update should be removed")
+	}
+}
+
+func TestChangePubKey(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := types.Address{}
+	coin := types.GetBaseCoin()
+	amount := big.NewInt(1)
+	pubkey := createTestCandidate(st)
+
+	st.Candidates.Delegate(address, pubkey, coin, amount, big.NewInt(0))
+	st.Candidates.RecalculateStakes(height)
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	newPubkey := types.Pubkey{}
+	_, _ = rand.Read(newPubkey[:])
+
+	st.Candidates.ChangePubKey(pubkey, newPubkey)
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := NewState(2, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.Candidates.Exists(pubkey) {
+		t.Fatalf("Candidate with old public key still exists")
+	}
+
+	stake := loaded.Candidates.GetStakeOfAddress(newPubkey, address, coin)
+	if stake == nil || stake.Value.Cmp(amount) != 0 {
+		t.Fatalf("Stake of address %s is not moved to new public key", address.String())
+	}
+
+	if loaded.Candidates.GetTotalStake(newPubkey).Cmp(amount) != 0 {
+		t.Fatalf("Total stake is not moved to new public key")
+	}
+
+	oldStakePath := append([]byte{'c'}, pubkey[:]...)
+	oldStakePath = append(oldStakePath, []byte("s0")...)
+	if _, value := loaded.tree.Get(oldStakePath); value != nil {
+		t.Fatalf("Stake of old public key is not removed")
+	}
+
+	if !loaded.Candidates.IsBlockedPubKey(pubkey) {
+		t.Fatalf("Old public key is not blocked")
+	}
+
+	if loaded.Candidates.IsBlockedPubKey(newPubkey) {
+		t.Fatalf("New public key should not be blocked")
+	}
+
+	lastPubkey := types.Pubkey{}
+	_, _ = rand.Read(lastPubkey[:])
+	loaded.Candidates.ChangePubKey(newPubkey, lastPubkey)
+
+	lastAddress := loaded.Candidates.GetCandidate(lastPubkey).GetTmAddress()
+	for _, key := range []types.Pubkey{pubkey, newPubkey, lastPubkey} {
+		if address := loaded.Candidates.CurrentTmAddress(tmAddress(key)); address != lastAddress {
+			t.Errorf("Address of %s should be resolved to %x, got %x", key.String(), lastAddress, address)
+		}
+	}
+
+	if candidate := loaded.Candidates.GetCandidateByTendermintAddress(loaded.Candidates.CurrentTmAddress(tmAddress(pubkey))); candidate == nil || candidate.PubKey != lastPubkey {
+		t.Fatalf("Candidate is not found by the address of old public key")
+	}
+
+	if _, err := loaded.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	appState := loaded.Export(3)
+	if len(appState.PubKeyChanges) != 2 || len(appState.BlockListCandidates) != 0 {
+		t.Fatalf("Public key changes should be exported with their new keys, got %d changes and %d blocked keys", len(appState.PubKeyChanges), len(appState.BlockListCandidates))
+	}
+
+	imported, err := NewState(0, db.NewMemDB(), emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := imported.Import(appState); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []types.Pubkey{pubkey, newPubkey} {
+		if !imported.Candidates.IsBlockedPubKey(key) {
+			t.Errorf("Imported public key %s should be blocked", key.String())
+		}
+
+		if address := imported.Candidates.CurrentTmAddress(tmAddress(key)); address != lastAddress {
+			t.Errorf("Address of imported %s should be resolved to %x, got %x", key.String(), lastAddress, address)
+		}
+	}
+}
+
+func TestChangePubKeyOfFrozenFunds(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := types.Address{}
+	coin := types.GetBaseCoin()
+	pubkey := createTestCandidate(st)
+	otherPubkey := createTestCandidate(st)
+
+	// funds frozen before frozen funds are indexed
+	st.FrozenFunds.AddFund(height+10, address, pubkey, coin, big.NewInt(1))
+	st.FrozenFunds.AddFund(height+10, address, otherPubkey, coin, big.NewInt(2))
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	st.FrozenFunds.IndexCandidates(height)
+	st.FrozenFunds.AddFund(height+candidates.UnbondPeriod, address, pubkey, coin, big.NewInt(3))
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := NewState(2, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newPubkey := types.Pubkey{}
+	_, _ = rand.Read(newPubkey[:])
+	loaded.FrozenFunds.ChangePubKey(pubkey, newPubkey)
+
+	expected := map[uint64][]types.Pubkey{
+		height + 10:                      {newPubkey, otherPubkey},
+		height + candidates.UnbondPeriod: {newPubkey},
+	}
+	for h, keys := range expected {
+		funds := loaded.FrozenFunds.GetFrozenFunds(h)
+		if funds == nil || len(funds.List) != len(keys) {
+			t.Fatalf("Frozen funds at %d not found", h)
+		}
+
+		for i, key := range keys {
+			if *funds.List[i].CandidateKey != key {
+				t.Errorf("Public key of frozen fund %d at %d should be %s, got %s", i, h, key.String(), funds.List[i].CandidateKey.String())
+			}
+		}
+	}
+}
+
+func tmAddress(pubkey types.Pubkey) types.TmAddress {
+	var key ed25519.PubKeyEd25519
+	copy(key[:], pubkey[:])
+
+	var address types.TmAddress
+	copy(address[:], key.Address().Bytes())
+
+	return address
+}
+
 func getState() *State {
 	s, err := NewState(0, db.NewMemDB(), emptyEvents{}, 1, 1)
 
@@ -414,3 +625,125 @@ type emptyEvents struct{}
 func (e emptyEvents) AddEvent(height uint32, event eventsdb.Event) {}
 func (e emptyEvents) LoadEvents(height uint32) eventsdb.Events     { return eventsdb.Events{} }
 func (e emptyEvents) CommitEvents() error                          { return nil }
+
+func TestDelegationPolicy(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkey := createTestCandidate(st)
+	whitelisted := types.Address{1}
+	st.Candidates.SetDelegationPolicy(pubkey, &candidates.DelegationPolicy{
+		MinStake:  big.NewInt(100),
+		Private:   true,
+		Whitelist: []types.Address{whitelisted},
+	})
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := NewState(1, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := loaded.Candidates.GetCandidate(pubkey).GetDelegationPolicy()
+	if policy == nil || policy.MinStake.Cmp(big.NewInt(100)) != 0 || !policy.Private || !policy.IsWhitelisted(whitelisted) {
+		t.Fatalf("Delegation policy is not loaded correctly: %+v", policy)
+	}
+
+	loaded.Candidates.SetDelegationPolicy(pubkey, &candidates.DelegationPolicy{MinStake: big.NewInt(0)})
+	if _, err := loaded.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err = NewState(2, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.Candidates.GetCandidate(pubkey).GetDelegationPolicy() != nil {
+		t.Fatalf("Delegation policy should be removed")
+	}
+}
+
+func TestMigrateCandidatesToSplitKeys(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkey1 := createTestCandidate(st)
+	pubkey2 := createTestCandidate(st)
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, value := st.tree.Get([]byte{'c'}); value == nil {
+		t.Fatalf("Candidates should be stored in a single list before the migration")
+	}
+
+	st.Candidates.MigrateToSplitKeys()
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, value := st.tree.Get([]byte{'c'}); value != nil {
+		t.Fatalf("List of candidates is not removed")
+	}
+
+	if _, value := st.tree.Get([]byte{'c', 'i'}); value == nil {
+		t.Fatalf("Index of candidates is not written")
+	}
+
+	for _, pubkey := range []types.Pubkey{pubkey1, pubkey2} {
+		path := append([]byte{'c'}, pubkey[:]...)
+		path = append(path, 'd')
+		if _, value := st.tree.Get(path); value == nil {
+			t.Fatalf("Candidate %s is not written under its own key", pubkey.String())
+		}
+	}
+
+	loaded, err := NewState(2, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded.Candidates.SetOnline(pubkey1)
+	pubkey3 := createTestCandidate(loaded)
+	if _, err := loaded.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err = NewState(3, memDB, emptyEvents{}, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(loaded.Candidates.GetCandidates()) != 3 || !loaded.Candidates.Exists(pubkey3) {
+		t.Fatalf("Candidates are not loaded correctly after the migration")
+	}
+
+	if loaded.Candidates.GetCandidate(pubkey1).Status != candidates.CandidateStatusOnline {
+		t.Fatalf("Status of candidate is not saved")
+	}
+
+	if loaded.Candidates.GetCandidate(pubkey2).Status != candidates.CandidateStatusOffline {
+		t.Fatalf("Status of candidate should not change")
+	}
+
+	hash := loaded.tree.Hash()
+	loaded.Candidates.MigrateToSplitKeys()
+	if _, err := loaded.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(hash, loaded.tree.Hash()) {
+		t.Fatalf("Migration of already migrated candidates should not change the state")
+	}
+}
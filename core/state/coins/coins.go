@@ -183,6 +183,12 @@ func (c *Coins) markDirty(symbol types.CoinSymbol) {
 	c.dirty[symbol] = struct{}{}
 }
 
+// DirtyCoins returns symbols of coins which were changed since the last commit.
+// It should be called before Commit, which resets dirty flags.
+func (c *Coins) DirtyCoins() []types.CoinSymbol {
+	return c.getOrderedDirtyCoins()
+}
+
 func (c *Coins) getOrderedDirtyCoins() []types.CoinSymbol {
 	keys := make([]types.CoinSymbol, 0, len(c.dirty))
 	for k := range c.dirty {
@@ -216,6 +222,22 @@ func (c *Coins) Export(state *types.AppState) {
 	})
 }
 
+// Symbols returns symbols of all committed coins
+func (c *Coins) Symbols() []types.CoinSymbol {
+	var symbols []types.CoinSymbol
+	c.iavl.IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) == 1+types.CoinSymbolLength {
+			var symbol types.CoinSymbol
+			copy(symbol[:], key[1:])
+			symbols = append(symbols, symbol)
+		}
+
+		return false
+	})
+
+	return symbols
+}
+
 func (c *Coins) getFromMap(symbol types.CoinSymbol) *Model {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
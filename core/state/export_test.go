@@ -0,0 +1,141 @@
+package state
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"math/big"
+	"testing"
+)
+
+func TestIterateAccounts(t *testing.T) {
+	st := getState()
+
+	coin := types.StrToCoinSymbol("TEST")
+	for i := byte(1); i <= 3; i++ {
+		st.Accounts.AddBalance(types.Address{i}, types.GetBaseCoin(), big.NewInt(int64(i)))
+	}
+	st.Accounts.AddBalance(types.Address{2}, coin, big.NewInt(10))
+	st.Accounts.SetNonce(types.Address{3}, 5)
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var accounts []types.Account
+	var cursors [][]byte
+	collect := func(account types.Account, next []byte) bool {
+		accounts = append(accounts, account)
+		cursors = append(cursors, next)
+		return false
+	}
+
+	if err := st.Accounts.IterateAccounts(nil, collect); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(accounts) != 3 || accounts[0].Address != (types.Address{1}) || accounts[2].Nonce != 5 {
+		t.Fatalf("Wrong accounts: %+v", accounts)
+	}
+
+	if len(accounts[1].Balance) != 2 || accounts[1].Balance[1].Coin != coin || accounts[1].Balance[1].Value != "10" {
+		t.Fatalf("Wrong balances of the account: %+v", accounts[1].Balance)
+	}
+
+	cursor := cursors[0]
+	accounts = nil
+	if err := st.Accounts.IterateAccounts(cursor, func(account types.Account, next []byte) bool {
+		accounts = append(accounts, account)
+		return true
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(accounts) != 1 || accounts[0].Address != (types.Address{2}) {
+		t.Fatalf("Iteration should resume from the account after the cursor and stop, got %+v", accounts)
+	}
+
+	if err := st.Accounts.IterateAccounts([]byte{'x'}, collect); err == nil {
+		t.Fatalf("Expected error for invalid cursor")
+	}
+}
+
+func TestIterateStakes(t *testing.T) {
+	st := getState()
+
+	pubkey := createTestCandidate(st)
+	for i := byte(1); i <= 3; i++ {
+		st.Candidates.Delegate(types.Address{i}, pubkey, types.GetBaseCoin(), big.NewInt(int64(i)), big.NewInt(0))
+	}
+	st.Candidates.RecalculateStakes(height)
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var stakes []*candidates.Stake
+	var cursors [][]byte
+	if err := st.Candidates.IterateStakes(nil, func(pk types.Pubkey, stake *candidates.Stake, next []byte) bool {
+		if pk != pubkey {
+			t.Errorf("Wrong public key of the stake %s", pk.String())
+		}
+		stakes = append(stakes, stake)
+		cursors = append(cursors, next)
+		return false
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stakes) != 3 {
+		t.Fatalf("Expected 3 stakes, got %d", len(stakes))
+	}
+
+	var rest int
+	if err := st.Candidates.IterateStakes(cursors[0], func(types.Pubkey, *candidates.Stake, []byte) bool {
+		rest++
+		return false
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if rest != 2 {
+		t.Fatalf("Iteration should resume after the cursor, got %d stakes", rest)
+	}
+}
+
+func TestIterateFrozenFunds(t *testing.T) {
+	st := getState()
+
+	pubkey := types.Pubkey{1}
+	st.FrozenFunds.AddFund(10, types.Address{1}, pubkey, types.GetBaseCoin(), big.NewInt(1))
+	st.FrozenFunds.AddFund(10, types.Address{2}, pubkey, types.GetBaseCoin(), big.NewInt(2))
+	st.FrozenFunds.AddFund(20, types.Address{3}, pubkey, types.GetBaseCoin(), big.NewInt(3))
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var funds []types.FrozenFund
+	var cursors [][]byte
+	collect := func(fund types.FrozenFund, next []byte) bool {
+		funds = append(funds, fund)
+		cursors = append(cursors, next)
+		return false
+	}
+
+	if err := st.FrozenFunds.IterateFrozenFunds(nil, collect); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(funds) != 3 || funds[0].Height != 10 || funds[1].Value != "2" || funds[2].Height != 20 {
+		t.Fatalf("Wrong frozen funds: %+v", funds)
+	}
+
+	funds = nil
+	if err := st.FrozenFunds.IterateFrozenFunds(cursors[0], collect); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(funds) != 2 || funds[0].Address != (types.Address{2}) || funds[1].Height != 20 {
+		t.Fatalf("Iteration should resume from the fund after the cursor, got %+v", funds)
+	}
+}
@@ -1,6 +1,7 @@
 package frozenfunds
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	eventsdb "github.com/MinterTeam/events-db"
@@ -16,12 +17,19 @@ import (
 	"sync"
 )
 
-const mainPrefix = byte('f')
+const (
+	mainPrefix       = byte('f')
+	candidatesPrefix = byte('c')
+)
 
 type FrozenFunds struct {
 	list  map[uint64]*Model
 	dirty map[uint64]interface{}
 
+	// indexed is true if heights of frozen funds are indexed by candidates, see IndexCandidates
+	indexed    bool
+	candidates map[types.Pubkey]*candidateHeights
+
 	bus  *bus.Bus
 	iavl tree.Tree
 
@@ -29,9 +37,12 @@ type FrozenFunds struct {
 }
 
 func NewFrozenFunds(stateBus *bus.Bus, iavl tree.Tree) (*FrozenFunds, error) {
-	frozenfunds := &FrozenFunds{bus: stateBus, iavl: iavl, list: map[uint64]*Model{}, dirty: map[uint64]interface{}{}}
+	frozenfunds := &FrozenFunds{bus: stateBus, iavl: iavl, list: map[uint64]*Model{}, dirty: map[uint64]interface{}{}, candidates: map[types.Pubkey]*candidateHeights{}}
 	frozenfunds.bus.SetFrozenFunds(NewBus(frozenfunds))
 
+	_, marker := iavl.Get([]byte{mainPrefix, candidatesPrefix})
+	frozenfunds.indexed = len(marker) != 0
+
 	return frozenfunds, nil
 }
 
@@ -53,9 +64,71 @@ func (f *FrozenFunds) Commit() error {
 		f.iavl.Set(path, data)
 	}
 
+	for _, pubkey := range f.getOrderedDirtyCandidates() {
+		index := f.candidates[pubkey]
+		index.isDirty = false
+
+		path := getCandidatePath(pubkey)
+		if len(index.Heights) == 0 {
+			f.iavl.Remove(path)
+			continue
+		}
+
+		data, err := rlp.EncodeToBytes(index)
+		if err != nil {
+			return fmt.Errorf("can't encode frozen funds index of %s: %v", pubkey.String(), err)
+		}
+
+		f.iavl.Set(path, data)
+	}
+
 	return nil
 }
 
+// IndexCandidates indexes heights of frozen funds by candidates, which lets ChangePubKey visit only heights with
+// funds of the candidate. Funds unfreezing from the height are indexed, the index is maintained since then.
+func (f *FrozenFunds) IndexCandidates(height uint64) {
+	if f.indexed {
+		return
+	}
+
+	f.indexed = true
+	f.iavl.Set([]byte{mainPrefix, candidatesPrefix}, []byte{1})
+
+	heights := map[uint64]bool{}
+	f.iavl.IterateRange(getPath(height), getPath(height+candidates.UnbondPeriod+1), true, func(key []byte, value []byte) bool {
+		heights[binary.BigEndian.Uint64(key[1:])] = true
+		return false
+	})
+
+	f.lock.RLock()
+	for cBlock := range f.list {
+		if cBlock >= height && cBlock <= height+candidates.UnbondPeriod {
+			heights[cBlock] = true
+		}
+	}
+	f.lock.RUnlock()
+
+	ordered := make([]uint64, 0, len(heights))
+	for cBlock := range heights {
+		ordered = append(ordered, cBlock)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i] < ordered[j]
+	})
+
+	for _, cBlock := range ordered {
+		ff := f.get(cBlock)
+		if ff == nil || ff.deleted {
+			continue
+		}
+
+		for _, item := range ff.List {
+			f.addToIndex(*item.CandidateKey, cBlock)
+		}
+	}
+}
+
 func (f *FrozenFunds) GetFrozenFunds(height uint64) *Model {
 	return f.get(height)
 }
@@ -114,6 +187,32 @@ func (f *FrozenFunds) PunishFrozenFundsWithAddress(fromHeight uint64, toHeight u
 	}
 }
 
+// ChangePubKey replaces candidate's public key in frozen funds. Frozen funds should be indexed by candidates.
+func (f *FrozenFunds) ChangePubKey(old types.Pubkey, new types.Pubkey) {
+	index := f.getCandidateHeights(old)
+	for _, cBlock := range index.Heights {
+		ff := f.get(cBlock)
+		if ff == nil {
+			continue
+		}
+
+		for i, item := range ff.List {
+			if *item.CandidateKey != old {
+				continue
+			}
+
+			pubkey := new
+			ff.List[i].CandidateKey = &pubkey
+		}
+
+		f.markDirty(cBlock)
+		f.addToIndex(new, cBlock)
+	}
+
+	index.Heights = nil
+	index.isDirty = true
+}
+
 func (f *FrozenFunds) GetOrNew(height uint64) *Model {
 	ff := f.get(height)
 	if ff == nil {
@@ -154,6 +253,12 @@ func (f *FrozenFunds) markDirty(height uint64) {
 	f.dirty[height] = struct{}{}
 }
 
+// DirtyFrozenFunds returns heights of frozen funds which were changed since the last commit.
+// It should be called before Commit, which resets dirty flags.
+func (f *FrozenFunds) DirtyFrozenFunds() []uint64 {
+	return f.getOrderedDirty()
+}
+
 func (f *FrozenFunds) getOrderedDirty() []uint64 {
 	keys := make([]uint64, 0, len(f.dirty))
 	for k := range f.dirty {
@@ -170,6 +275,10 @@ func (f *FrozenFunds) getOrderedDirty() []uint64 {
 func (f *FrozenFunds) AddFund(height uint64, address types.Address, pubkey types.Pubkey, coin types.CoinSymbol, value *big.Int) {
 	f.GetOrNew(height).addFund(address, pubkey, coin, value)
 	f.bus.Checker().AddCoin(coin, value)
+
+	if f.indexed {
+		f.addToIndex(pubkey, height)
+	}
 }
 
 func (f *FrozenFunds) Delete(height uint64) {
@@ -182,7 +291,73 @@ func (f *FrozenFunds) Delete(height uint64) {
 
 	for _, fund := range ff.List {
 		f.bus.Checker().AddCoin(fund.Coin, big.NewInt(0).Neg(fund.Value))
+
+		if f.indexed {
+			f.removeFromIndex(*fund.CandidateKey, height)
+		}
+	}
+}
+
+func (f *FrozenFunds) getCandidateHeights(pubkey types.Pubkey) *candidateHeights {
+	if index, ok := f.candidates[pubkey]; ok {
+		return index
+	}
+
+	index := &candidateHeights{}
+	if _, enc := f.iavl.Get(getCandidatePath(pubkey)); len(enc) != 0 {
+		if err := rlp.DecodeBytes(enc, index); err != nil {
+			panic(fmt.Sprintf("failed to decode frozen funds index of %s: %s", pubkey.String(), err))
+		}
+	}
+
+	f.candidates[pubkey] = index
+
+	return index
+}
+
+func (f *FrozenFunds) addToIndex(pubkey types.Pubkey, height uint64) {
+	index := f.getCandidateHeights(pubkey)
+
+	i := sort.Search(len(index.Heights), func(i int) bool {
+		return index.Heights[i] >= height
+	})
+	if i < len(index.Heights) && index.Heights[i] == height {
+		return
 	}
+
+	index.Heights = append(index.Heights, 0)
+	copy(index.Heights[i+1:], index.Heights[i:])
+	index.Heights[i] = height
+	index.isDirty = true
+}
+
+func (f *FrozenFunds) removeFromIndex(pubkey types.Pubkey, height uint64) {
+	index := f.getCandidateHeights(pubkey)
+
+	i := sort.Search(len(index.Heights), func(i int) bool {
+		return index.Heights[i] >= height
+	})
+	if i == len(index.Heights) || index.Heights[i] != height {
+		return
+	}
+
+	index.Heights = append(index.Heights[:i], index.Heights[i+1:]...)
+	index.isDirty = true
+}
+
+func (f *FrozenFunds) getOrderedDirtyCandidates() []types.Pubkey {
+	var keys []types.Pubkey
+	for pubkey, index := range f.candidates {
+		if index.isDirty {
+			keys = append(keys, pubkey)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) == -1
+	})
+
+	return keys
 }
 
 func (f *FrozenFunds) Export(state *types.AppState, height uint64) {
@@ -203,6 +378,60 @@ func (f *FrozenFunds) Export(state *types.AppState, height uint64) {
 	}
 }
 
+// IterateFrozenFunds calls fn for committed frozen funds in order of heights of unfreezing starting from the cursor,
+// nil cursor means the first fund. Cursor passed to fn points to the next fund. Iteration stops when fn returns true.
+func (f *FrozenFunds) IterateFrozenFunds(cursor []byte, fn func(fund types.FrozenFund, next []byte) bool) error {
+	start, skip := []byte{mainPrefix}, uint32(0)
+	if cursor != nil {
+		if len(cursor) != 1+8+4 || cursor[0] != mainPrefix {
+			return fmt.Errorf("invalid cursor %x", cursor)
+		}
+		start, skip = cursor[:1+8], binary.BigEndian.Uint32(cursor[1+8:])
+	}
+
+	var err error
+	f.iavl.IterateRange(start, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) != 1+8 {
+			return false
+		}
+
+		height := binary.BigEndian.Uint64(key[1:])
+		ff := &Model{}
+		if err = rlp.DecodeBytes(value, ff); err != nil {
+			err = fmt.Errorf("failed to decode frozen funds at height %d: %s", height, err)
+			return true
+		}
+
+		// funds before the cursor are skipped only at its height
+		from := uint32(0)
+		if bytes.Equal(key, start) {
+			from = skip
+		}
+
+		for i := from; i < uint32(len(ff.List)); i++ {
+			item := ff.List[i]
+
+			next := make([]byte, 1+8+4)
+			copy(next, key)
+			binary.BigEndian.PutUint32(next[1+8:], i+1)
+
+			if fn(types.FrozenFund{
+				Height:       height,
+				Address:      item.Address,
+				CandidateKey: item.CandidateKey,
+				Coin:         item.Coin,
+				Value:        item.Value.String(),
+			}, next) {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	return err
+}
+
 func (f *FrozenFunds) getFromMap(height uint64) *Model {
 	f.lock.RLock()
 	defer f.lock.RUnlock()
@@ -217,6 +446,10 @@ func (f *FrozenFunds) setToMap(height uint64, model *Model) {
 	f.list[height] = model
 }
 
+func getCandidatePath(pubkey types.Pubkey) []byte {
+	return append([]byte{mainPrefix, candidatesPrefix}, pubkey[:]...)
+}
+
 func getPath(height uint64) []byte {
 	b := make([]byte, 8)
 	binary.BigEndian.PutUint64(b, height)
@@ -38,3 +38,15 @@ func (m *Model) addFund(address types.Address, pubkey types.Pubkey, coin types.C
 func (m *Model) Height() uint64 {
 	return m.height
 }
+
+// IsDeleted returns true if the funds are deleted and will be removed on commit
+func (m *Model) IsDeleted() bool {
+	return m.deleted
+}
+
+// candidateHeights are heights of frozen funds of the candidate in ascending order
+type candidateHeights struct {
+	Heights []uint64
+
+	isDirty bool
+}
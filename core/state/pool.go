@@ -0,0 +1,160 @@
+package state
+
+import (
+	"container/list"
+	db "github.com/tendermint/tm-db"
+	"sync"
+	"sync/atomic"
+)
+
+// ViewPool keeps immutable states of recent heights to be reused by readers.
+// A view is shared by all concurrent readers of its height and data loaded lazily into the state,
+// e.g. candidates and stakes, is reused by them. Readers load such data under Lock of the state
+// and read the state under RLock, the same way as they use the current state.
+// Views which are in use are never evicted or pruned, so the pool may temporarily grow beyond its size.
+type ViewPool struct {
+	db      db.DB
+	archive *Archive
+	size    int
+
+	lock  sync.Mutex
+	views map[uint64]*view
+	order *list.List
+}
+
+type view struct {
+	height uint64
+
+	once  sync.Once
+	state *State
+	err   error
+
+	refs    int           // number of readers which use the view
+	pruned  bool          // view should be removed when it is released by all readers
+	element *list.Element // nil if view is removed from the pool
+}
+
+// NewViewPool creates pool of size views. If size is not positive, every call creates a new state.
+func NewViewPool(db db.DB, size int) *ViewPool {
+	return &ViewPool{
+		db:    db,
+		size:  size,
+		views: map[uint64]*view{},
+		order: list.New(),
+	}
+}
+
+// SetArchive sets archive of states which are deleted from the state database
+func (p *ViewPool) SetArchive(archive *Archive) {
+	p.archive = archive
+}
+
+// Acquire returns immutable state at given height. Release should be called when the state is not used anymore.
+func (p *ViewPool) Acquire(height uint64) (*State, func(), error) {
+	if p.size <= 0 {
+		s, err := p.load(height)
+		return s, func() {}, err
+	}
+
+	p.lock.Lock()
+	v, ok := p.views[height]
+	if ok {
+		p.order.MoveToFront(v.element)
+	} else {
+		v = &view{height: height}
+		v.element = p.order.PushFront(v)
+		p.views[height] = v
+	}
+	v.refs++
+	p.evict()
+	p.lock.Unlock()
+
+	// the first reader loads the state, the others wait for it
+	v.once.Do(func() {
+		v.state, v.err = p.load(height)
+	})
+
+	var released int32
+	release := func() {
+		if !atomic.CompareAndSwapInt32(&released, 0, 1) {
+			return
+		}
+
+		p.lock.Lock()
+		v.refs--
+		// failed views are removed at once, so the following readers load the state again
+		if v.err != nil || (v.refs == 0 && v.pruned) {
+			p.remove(v)
+		}
+		p.evict()
+		p.lock.Unlock()
+	}
+
+	if v.err != nil {
+		release()
+		return nil, nil, v.err
+	}
+
+	return v.state, release, nil
+}
+
+func (p *ViewPool) load(height uint64) (*State, error) {
+	s, err := NewCheckStateAtHeight(height, p.db)
+	if err != nil && p.archive.Has(height) {
+		return p.archive.State(height)
+	}
+
+	return s, err
+}
+
+// Prune removes views of heights up to the given one except those kept by the predicate, e.g. snapshots,
+// their versions are deleted from the tree. Views which are in use are removed when they are released by all readers.
+func (p *ViewPool) Prune(height uint64, keep func(height uint64) bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for h, v := range p.views {
+		if h > height || (keep != nil && keep(h)) {
+			continue
+		}
+
+		if v.refs > 0 {
+			v.pruned = true
+			continue
+		}
+
+		p.remove(v)
+	}
+}
+
+// Len returns number of views in the pool
+func (p *ViewPool) Len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return len(p.views)
+}
+
+// evict removes least recently used views which are not in use until the pool fits its size
+func (p *ViewPool) evict() {
+	for item := p.order.Back(); item != nil && len(p.views) > p.size; {
+		prev := item.Prev()
+		if v := item.Value.(*view); v.refs == 0 {
+			p.remove(v)
+		}
+		item = prev
+	}
+}
+
+func (p *ViewPool) remove(v *view) {
+	if v.element == nil {
+		return
+	}
+
+	p.order.Remove(v.element)
+	v.element = nil
+
+	if p.views[v.height] == v {
+		delete(p.views, v.height)
+	}
+}
@@ -0,0 +1,196 @@
+package state
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/tendermint/tm-db"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestViewPool(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pool := NewViewPool(memDB, 1)
+
+	first, releaseFirst, err := pool.Acquire(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, releaseSecond, err := pool.Acquire(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first != second {
+		t.Fatalf("Concurrent readers of the same height should share the view")
+	}
+
+	_, releaseOther, err := pool.Acquire(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pool.Len() != 2 {
+		t.Fatalf("Views in use should not be evicted, got %d views", pool.Len())
+	}
+
+	releaseSecond()
+	releaseSecond()
+	if pool.Len() != 2 {
+		t.Fatalf("View should not be evicted until all readers release it, got %d views", pool.Len())
+	}
+
+	releaseFirst()
+	if pool.Len() != 1 {
+		t.Fatalf("Released view should be evicted, got %d views", pool.Len())
+	}
+
+	releaseOther()
+
+	third, releaseThird, err := pool.Acquire(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	releaseThird()
+
+	if third == first {
+		t.Fatalf("Evicted view should be loaded again")
+	}
+
+	fourth, releaseFourth, err := pool.Acquire(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	releaseFourth()
+
+	if fourth != third {
+		t.Fatalf("Released view should be reused by the following reader")
+	}
+
+	pool.Prune(2, func(height uint64) bool {
+		return height == 1
+	})
+	if pool.Len() != 1 {
+		t.Fatalf("Kept view should not be pruned, got %d views", pool.Len())
+	}
+
+	pool.Prune(2, nil)
+	if pool.Len() != 0 {
+		t.Fatalf("Pruned views should be removed, got %d views", pool.Len())
+	}
+
+	if _, _, err := pool.Acquire(100); err == nil {
+		t.Fatalf("Acquiring not existing height should fail")
+	}
+
+	if _, _, err := pool.Acquire(100); err == nil {
+		t.Fatalf("Acquiring not existing height should fail again")
+	}
+
+	if pool.Len() != 0 {
+		t.Fatalf("Failed views should not be kept, got %d views", pool.Len())
+	}
+}
+
+func TestViewPoolPruneInUse(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pool := NewViewPool(memDB, 2)
+
+	first, release, err := pool.Acquire(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool.Prune(1, nil)
+	if pool.Len() != 1 {
+		t.Fatalf("View in use should not be pruned, got %d views", pool.Len())
+	}
+
+	if balance := first.Accounts.GetBalance(types.Address{}, types.GetBaseCoin()); balance.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("Balance of pruned view in use is not correct, got %s", balance)
+	}
+
+	release()
+	if pool.Len() != 0 {
+		t.Fatalf("Pruned view should be removed on release, got %d views", pool.Len())
+	}
+}
+
+func TestViewPoolConcurrentReaders(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := NewViewPool(memDB, 1)
+
+	states := make([]*State, 10)
+	var wg sync.WaitGroup
+	for i := range states {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			s, release, err := pool.Acquire(1)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+
+			s.Lock()
+			s.Candidates.LoadCandidates()
+			s.Unlock()
+
+			s.RLock()
+			defer s.RUnlock()
+
+			if balance := s.Accounts.GetBalance(types.Address{}, types.GetBaseCoin()); balance.Cmp(big.NewInt(1)) != 0 {
+				t.Errorf("Wrong balance %s", balance)
+			}
+			states[i] = s
+		}(i)
+	}
+	wg.Wait()
+
+	for _, s := range states {
+		if s != states[0] {
+			t.Fatalf("Concurrent readers of the same height should share the view")
+		}
+	}
+
+	if pool.Len() != 1 {
+		t.Fatalf("Pool should keep one view of the height, got %d views", pool.Len())
+	}
+}
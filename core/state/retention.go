@@ -0,0 +1,53 @@
+package state
+
+// Retention defines versions of the state kept on commit: the last KeepLast versions and snapshots,
+// every version divisible by Interval. Snapshots are moved to Archive when it is set.
+type Retention struct {
+	KeepLast int64
+	Interval int64
+	Archive  *Archive
+}
+
+// IsSnapshot reports whether the version is kept forever
+func (r Retention) IsSnapshot(version int64) bool {
+	return r.Interval > 0 && version > 0 && version%r.Interval == 0
+}
+
+// Nearest returns available version closest to the pruned one, the lower one if there are two of them.
+// Latest is the last saved version, 0 is returned if no version is available.
+func (r Retention) Nearest(version, latest int64, exists func(version int64) bool) int64 {
+	var candidates []int64
+	if r.Interval > 0 {
+		lower := version / r.Interval * r.Interval
+		candidates = append(candidates, lower, lower+r.Interval)
+	}
+
+	// the oldest of recent versions
+	oldest := latest - r.KeepLast + 1
+	if oldest < 1 {
+		oldest = 1
+	}
+	candidates = append(candidates, oldest)
+
+	var nearest int64
+	for _, candidate := range candidates {
+		if candidate < 1 || candidate > latest || !exists(candidate) {
+			continue
+		}
+
+		if nearest == 0 || distance(candidate, version) < distance(nearest, version) ||
+			(distance(candidate, version) == distance(nearest, version) && candidate < nearest) {
+			nearest = candidate
+		}
+	}
+
+	return nearest
+}
+
+func distance(a, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
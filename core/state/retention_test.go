@@ -0,0 +1,194 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestRetention(t *testing.T) {
+	st, err := NewState(0, db.NewMemDB(), emptyEvents{}, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.SetRetention(Retention{KeepLast: 2, Interval: 5})
+
+	for i := 0; i < 12; i++ {
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for version, exists := range map[int64]bool{3: false, 5: true, 9: false, 10: true, 11: true, 12: true} {
+		if st.HasVersion(version) != exists {
+			t.Errorf("Existence of version %d should be %t", version, exists)
+		}
+	}
+
+	cases := map[int64]int64{3: 5, 7: 5, 8: 10, 9: 10}
+	for version, expected := range cases {
+		if nearest := st.retention.Nearest(version, 12, st.HasVersion); nearest != expected {
+			t.Errorf("Nearest version to %d should be %d, got %d", version, expected, nearest)
+		}
+	}
+}
+
+func TestArchive(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := NewState(0, memDB, emptyEvents{}, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := NewArchive(db.NewMemDB(), log.NewNopLogger())
+	st.SetRetention(Retention{KeepLast: 2, Interval: 5, Archive: archive})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go archive.Run(ctx)
+
+	commit := func() {
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		commit()
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); !archive.Has(5); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("Snapshot should be archived")
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		commit()
+	}
+
+	if st.HasVersion(5) {
+		t.Fatalf("Archived snapshot should be deleted from the state database")
+	}
+
+	pool := NewViewPool(memDB, 1)
+	pool.SetArchive(archive)
+
+	archived, release, err := pool.Acquire(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	if balance := archived.Accounts.GetBalance(types.Address{}, types.GetBaseCoin()); balance.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("Wrong balance in archived state. Expected 5, got %s", balance)
+	}
+}
+
+func TestArchivePendingAfterRestart(t *testing.T) {
+	memDB := db.NewMemDB()
+	archiveDB := db.NewMemDB()
+
+	st, err := NewState(0, memDB, emptyEvents{}, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// archive is not running, snapshot stays in the queue until restart
+	st.SetRetention(Retention{KeepLast: 2, Interval: 5, Archive: NewArchive(archiveDB, log.NewNopLogger())})
+	for i := 0; i < 6; i++ {
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	st, err = NewState(6, memDB, emptyEvents{}, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := NewArchive(archiveDB, log.NewNopLogger())
+	st.SetRetention(Retention{KeepLast: 2, Interval: 5, Archive: archive})
+
+	if pending := archive.Pending(); len(pending) != 1 || pending[0] != 5 {
+		t.Fatalf("Pending snapshot should survive restart, got %v", pending)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go archive.Run(ctx)
+
+	for deadline := time.Now().Add(5 * time.Second); st.HasVersion(5); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("Pending snapshot should be archived and deleted from the state database")
+		}
+
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !archive.Has(5) || len(archive.Pending()) != 0 {
+		t.Fatalf("Snapshot should be archived and removed from pending")
+	}
+}
+
+// failingDoneDB fails to mark snapshots as archived
+type failingDoneDB struct {
+	db.DB
+}
+
+func (d failingDoneDB) SetSync(key []byte, value []byte) error {
+	if key[0] == archiveDonePrefix {
+		return errors.New("disk is full")
+	}
+
+	return d.DB.SetSync(key, value)
+}
+
+func TestArchiveGivesUp(t *testing.T) {
+	st, err := NewState(0, db.NewMemDB(), emptyEvents{}, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := NewArchive(failingDoneDB{db.NewMemDB()}, log.NewNopLogger())
+	st.SetRetention(Retention{KeepLast: 2, Interval: 5, Archive: archive})
+
+	commit := func() {
+		st.Accounts.AddBalance(types.Address{}, types.GetBaseCoin(), big.NewInt(1))
+		if _, err := st.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		commit()
+	}
+
+	for attempt := 1; attempt <= maxArchiveAttempts; attempt++ {
+		if pending := archive.Pending(); len(pending) != 1 {
+			t.Fatalf("Failed snapshot should stay pending before attempt %d", attempt)
+		}
+
+		archive.save(<-archive.queue)
+		commit()
+	}
+
+	if len(archive.Pending()) != 0 || archive.IsQueued(5) {
+		t.Fatalf("Snapshot should be dropped from pending after %d attempts", maxArchiveAttempts)
+	}
+
+	if !st.HasVersion(5) {
+		t.Fatalf("Snapshot which is not archived should be kept in the state database")
+	}
+}
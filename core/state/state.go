@@ -19,6 +19,7 @@ import (
 	db "github.com/tendermint/tm-db"
 	"math/big"
 	"sync"
+	"time"
 )
 
 type State struct {
@@ -31,11 +32,13 @@ type State struct {
 	Checks      *checks.Checks
 	Checker     *checker.Checker
 
-	db             db.DB
-	events         eventsdb.IEventsDB
-	tree           tree.Tree
-	keepLastStates int64
-	bus            *bus.Bus
+	db        db.DB
+	events    eventsdb.IEventsDB
+	tree      tree.Tree
+	retention Retention
+	bus       *bus.Bus
+
+	commitDurations map[string]time.Duration
 
 	lock sync.RWMutex
 }
@@ -108,41 +111,89 @@ func (s *State) Check() error {
 func (s *State) Commit() ([]byte, error) {
 	s.Checker.Reset()
 
-	if err := s.Accounts.Commit(); err != nil {
-		return nil, err
+	modules := []struct {
+		name   string
+		module interface{ Commit() error }
+	}{
+		{"accounts", s.Accounts},
+		{"app", s.App},
+		{"coins", s.Coins},
+		{"candidates", s.Candidates},
+		{"validators", s.Validators},
+		{"checks", s.Checks},
+		{"frozen_funds", s.FrozenFunds},
 	}
 
-	if err := s.App.Commit(); err != nil {
-		return nil, err
+	durations := make(map[string]time.Duration, len(modules)+1)
+	for _, m := range modules {
+		start := time.Now()
+		if err := m.module.Commit(); err != nil {
+			return nil, err
+		}
+		durations[m.name] = time.Since(start)
 	}
 
-	if err := s.Coins.Commit(); err != nil {
-		return nil, err
-	}
+	start := time.Now()
+	hash, version, err := s.tree.SaveVersion()
+	durations["tree"] = time.Since(start)
+	s.commitDurations = durations
 
-	if err := s.Candidates.Commit(); err != nil {
-		return nil, err
-	}
+	s.prune(version)
 
-	if err := s.Validators.Commit(); err != nil {
-		return nil, err
-	}
+	return hash, err
+}
 
-	if err := s.Checks.Commit(); err != nil {
-		return nil, err
+// CommitDurations returns time spent by the last Commit on each module of the state and on saving the tree
+func (s *State) CommitDurations() map[string]time.Duration {
+	return s.commitDurations
+}
+
+// SetRetention sets policy of deleting old versions of the state on commit
+func (s *State) SetRetention(retention Retention) {
+	s.retention = retention
+}
+
+// prune deletes versions of the state which are not kept by the retention policy.
+// Snapshots are deleted when they are moved to the archive, failed copies are retried on the following commits.
+func (s *State) prune(version int64) {
+	r := s.retention
+
+	if r.Archive != nil && r.IsSnapshot(version) {
+		r.Archive.Add(uint64(version))
 	}
 
-	if err := s.FrozenFunds.Commit(); err != nil {
-		return nil, err
+	if r.KeepLast < version-1 {
+		if old := version - r.KeepLast; !r.IsSnapshot(old) {
+			_ = s.tree.DeleteVersion(old)
+		}
 	}
 
-	hash, version, err := s.tree.SaveVersion()
+	for _, height := range r.Archive.Pending() {
+		if r.Archive.Has(height) {
+			if int64(height) <= version-r.KeepLast {
+				_ = s.tree.DeleteVersion(int64(height))
+				r.Archive.Remove(height)
+			}
+			continue
+		}
 
-	if s.keepLastStates < version-1 {
-		_ = s.tree.DeleteVersion(version - s.keepLastStates)
+		if r.Archive.IsQueued(height) {
+			continue
+		}
+
+		snapshot, err := s.tree.GetImmutableAtHeight(int64(height))
+		if err != nil {
+			// version is not in the state database anymore, there is nothing to archive
+			r.Archive.Remove(height)
+			continue
+		}
+		r.Archive.SaveAsync(height, snapshot)
 	}
+}
 
-	return hash, err
+// HasVersion reports whether the version of the state is kept in the state database
+func (s *State) HasVersion(version int64) bool {
+	return s.tree.VersionExists(version)
 }
 
 func (s *State) Import(state types.AppState) error {
@@ -187,6 +238,27 @@ func (s *State) Import(state types.AppState) error {
 
 		s.Candidates.SetTotalStake(c.PubKey, helpers.StringToBigInt(c.TotalBipStake))
 		s.Candidates.SetStakes(c.PubKey, c.Stakes)
+
+		if c.CommissionUpdate != nil {
+			s.Candidates.SetCommissionUpdate(c.PubKey, c.CommissionUpdate.Commission, c.CommissionUpdate.Height)
+		}
+
+		if c.DelegationPolicy != nil {
+			s.Candidates.SetDelegationPolicy(c.PubKey, &candidates.DelegationPolicy{
+				MinStake:     helpers.StringToBigInt(c.DelegationPolicy.MinStake),
+				AllowedCoins: c.DelegationPolicy.AllowedCoins,
+				Private:      c.DelegationPolicy.Private,
+				Whitelist:    c.DelegationPolicy.Whitelist,
+			})
+		}
+	}
+
+	for _, pubkey := range state.BlockListCandidates {
+		s.Candidates.BlockPubKey(pubkey)
+	}
+
+	for _, change := range state.PubKeyChanges {
+		s.Candidates.ImportPubKeyChange(change.Old, change.New)
 	}
 
 	for _, hashString := range state.UsedChecks {
@@ -273,10 +345,10 @@ func newStateForTree(iavlTree tree.Tree, events eventsdb.IEventsDB, db db.DB, ke
 		Checker:     stateChecker,
 		bus:         stateBus,
 
-		db:             db,
-		events:         events,
-		tree:           iavlTree,
-		keepLastStates: keepLastStates,
+		db:        db,
+		events:    events,
+		tree:      iavlTree,
+		retention: Retention{KeepLast: keepLastStates},
 	}
 
 	return state, nil
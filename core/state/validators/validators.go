@@ -29,6 +29,8 @@ type Validators struct {
 	list   []*Validator
 	loaded bool
 
+	changedPubKeys []PubKeyChange
+
 	iavl tree.Tree
 	bus  *bus.Bus
 }
@@ -69,6 +71,7 @@ func (v *Validators) Commit() error {
 	}
 
 	v.uncheckDirtyValidators()
+	v.changedPubKeys = nil
 
 	return nil
 }
@@ -342,6 +345,37 @@ func (v *Validators) SetToDrop(pubkey types.Pubkey) {
 	}
 }
 
+// PubKeyChange is a replacement of public key of a validator
+type PubKeyChange struct {
+	Old types.Pubkey
+	New types.Pubkey
+}
+
+// ChangePubKey replaces public key of the validator keeping its accumulated reward and absent times.
+// The old key should be replaced with the new one in Tendermint at the end of the block.
+func (v *Validators) ChangePubKey(old types.Pubkey, new types.Pubkey) {
+	for _, val := range v.list {
+		if val.PubKey != old {
+			continue
+		}
+
+		val.PubKey = new
+		val.setTmAddress()
+		val.isDirty = true
+		v.changedPubKeys = append(v.changedPubKeys, PubKeyChange{Old: old, New: new})
+	}
+}
+
+// HasChangedPubKeys reports whether public key of any validator was changed in the current block
+func (v *Validators) HasChangedPubKeys() bool {
+	return len(v.changedPubKeys) > 0
+}
+
+// ChangedPubKeys returns replacements of public keys of validators made in the current block in their order
+func (v *Validators) ChangedPubKeys() []PubKeyChange {
+	return v.changedPubKeys
+}
+
 func (v *Validators) turnValidatorOff(tmAddress types.TmAddress) {
 	validator := v.getByTmAddress(tmAddress)
 	validator.AbsentTimes = types.NewBitArray(ValidatorMaxAbsentWindow)
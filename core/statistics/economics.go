@@ -0,0 +1,182 @@
+package statistics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Economics is a snapshot of chain economics taken at the end of a block. Amounts are in pips.
+type Economics struct {
+	TotalStake   *big.Int
+	RewardPool   *big.Int
+	TotalSlashed *big.Int
+	MaxGas       uint64
+	MinGasPrice  uint32
+	Validators   []ValidatorEconomics
+	Coins        []CoinEconomics
+}
+
+type ValidatorEconomics struct {
+	PubKey      string
+	VotingPower int64
+	AccumReward *big.Int
+}
+
+type CoinEconomics struct {
+	Symbol  string
+	Reserve *big.Int
+	Volume  *big.Int
+}
+
+type economics struct {
+	sync.Mutex
+	totalStake     prometheus.Gauge
+	rewardPool     prometheus.Gauge
+	totalSlashed   prometheus.Gauge
+	maxGas         prometheus.Gauge
+	minGasPrice    prometheus.Gauge
+	votingPower    *prometheus.GaugeVec
+	accumReward    *prometheus.GaugeVec
+	coinReserve    *prometheus.GaugeVec
+	coinVolume     *prometheus.GaugeVec
+	txs            *prometheus.CounterVec
+	gasUsed        *prometheus.CounterVec
+	failedTxs      *prometheus.CounterVec
+	commitDuration *prometheus.GaugeVec
+
+	// public keys of validators of the last snapshot, metrics of dropped validators are deleted
+	validators map[string]struct{}
+}
+
+func newEconomics() *economics {
+	e := &economics{
+		totalStake: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "total_stake",
+			Help: "Total stake of all candidates in BIP",
+		}),
+		rewardPool: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "reward_pool",
+			Help: "Block reward and commissions distributed between validators in BIP",
+		}),
+		totalSlashed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "total_slashed",
+			Help: "Total slashed amount in BIP",
+		}),
+		maxGas: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "max_gas",
+			Help: "Max gas of the block",
+		}),
+		minGasPrice: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "min_gas_price",
+			Help: "Min gas price of the mempool",
+		}),
+		votingPower: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "validator_voting_power",
+			Help: "Voting power of the validator out of 100000000",
+		}, []string{"pub_key"}),
+		accumReward: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "validator_accum_reward",
+			Help: "Accumulated reward of the validator in BIP",
+		}, []string{"pub_key"}),
+		coinReserve: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "coin_reserve",
+			Help: "Reserve of the coin in BIP",
+		}, []string{"coin"}),
+		coinVolume: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "coin_volume",
+			Help: "Volume of the coin",
+		}, []string{"coin"}),
+		txs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "txs",
+			Help: "Delivered transactions by type",
+		}, []string{"type"}),
+		gasUsed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gas_used",
+			Help: "Gas used by delivered transactions by type",
+		}, []string{"type"}),
+		failedTxs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "failed_txs",
+			Help: "Failed delivered transactions by code",
+		}, []string{"code"}),
+		commitDuration: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "commit_duration",
+			Help: "Duration of the last commit of the state module",
+		}, []string{"module"}),
+		validators: map[string]struct{}{},
+	}
+
+	prometheus.MustRegister(e.totalStake, e.rewardPool, e.totalSlashed, e.maxGas, e.minGasPrice, e.votingPower,
+		e.accumReward, e.coinReserve, e.coinVolume, e.txs, e.gasUsed, e.failedTxs, e.commitDuration)
+
+	return e
+}
+
+func (d *Data) SetEconomics(e Economics) {
+	if d == nil {
+		return
+	}
+
+	d.Economics.Lock()
+	defer d.Economics.Unlock()
+
+	d.Economics.totalStake.Set(fromPips(e.TotalStake))
+	d.Economics.rewardPool.Set(fromPips(e.RewardPool))
+	d.Economics.totalSlashed.Set(fromPips(e.TotalSlashed))
+	d.Economics.maxGas.Set(float64(e.MaxGas))
+	d.Economics.minGasPrice.Set(float64(e.MinGasPrice))
+
+	validators := make(map[string]struct{}, len(e.Validators))
+	for _, validator := range e.Validators {
+		validators[validator.PubKey] = struct{}{}
+		d.Economics.votingPower.With(prometheus.Labels{"pub_key": validator.PubKey}).Set(float64(validator.VotingPower))
+		d.Economics.accumReward.With(prometheus.Labels{"pub_key": validator.PubKey}).Set(fromPips(validator.AccumReward))
+	}
+	for pubKey := range d.Economics.validators {
+		if _, ok := validators[pubKey]; !ok {
+			d.Economics.votingPower.Delete(prometheus.Labels{"pub_key": pubKey})
+			d.Economics.accumReward.Delete(prometheus.Labels{"pub_key": pubKey})
+		}
+	}
+	d.Economics.validators = validators
+
+	for _, coin := range e.Coins {
+		d.Economics.coinReserve.With(prometheus.Labels{"coin": coin.Symbol}).Set(fromPips(coin.Reserve))
+		d.Economics.coinVolume.With(prometheus.Labels{"coin": coin.Symbol}).Set(fromPips(coin.Volume))
+	}
+}
+
+// AddTx counts the delivered transaction, gas is counted for all transactions since failed ones pay commission too
+func (d *Data) AddTx(txType string, gasUsed int64, code uint32) {
+	if d == nil {
+		return
+	}
+
+	d.Economics.txs.With(prometheus.Labels{"type": txType}).Inc()
+	d.Economics.gasUsed.With(prometheus.Labels{"type": txType}).Add(float64(gasUsed))
+	if code != 0 {
+		d.Economics.failedTxs.With(prometheus.Labels{"code": strconv.FormatUint(uint64(code), 10)}).Inc()
+	}
+}
+
+func (d *Data) SetCommitDurations(durations map[string]time.Duration) {
+	if d == nil {
+		return
+	}
+
+	for module, duration := range durations {
+		d.Economics.commitDuration.With(prometheus.Labels{"module": module}).Set(duration.Seconds())
+	}
+}
+
+// fromPips converts amount in pips to float amount of coins, precision loss is fine for metrics
+func fromPips(value *big.Int) float64 {
+	if value == nil {
+		return 0
+	}
+
+	result, _ := new(big.Float).Quo(new(big.Float).SetInt(value), big.NewFloat(1e18)).Float64()
+	return result
+}
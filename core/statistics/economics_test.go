@@ -0,0 +1,51 @@
+package statistics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"math/big"
+	"testing"
+)
+
+func TestEconomics(t *testing.T) {
+	data := New()
+
+	bip := big.NewInt(1e18)
+	data.SetEconomics(Economics{
+		TotalStake:   big.NewInt(0).Mul(bip, big.NewInt(100)),
+		RewardPool:   bip,
+		TotalSlashed: big.NewInt(0),
+		MaxGas:       100000,
+		MinGasPrice:  1,
+		Validators: []ValidatorEconomics{
+			{PubKey: "Mp01", VotingPower: 60000000, AccumReward: bip},
+			{PubKey: "Mp02", VotingPower: 40000000, AccumReward: big.NewInt(0)},
+		},
+		Coins: []CoinEconomics{{Symbol: "TEST", Reserve: bip, Volume: big.NewInt(0).Mul(bip, big.NewInt(10))}},
+	})
+
+	if value := testutil.ToFloat64(data.Economics.totalStake); value != 100 {
+		t.Fatalf("Expected total stake 100, got %f", value)
+	}
+
+	if value := testutil.ToFloat64(data.Economics.coinVolume.With(prometheus.Labels{"coin": "TEST"})); value != 10 {
+		t.Fatalf("Expected coin volume 10, got %f", value)
+	}
+
+	data.SetEconomics(Economics{
+		Validators: []ValidatorEconomics{{PubKey: "Mp01", VotingPower: 100000000, AccumReward: bip}},
+	})
+
+	if count := testutil.CollectAndCount(data.Economics.votingPower); count != 1 {
+		t.Fatalf("Expected metrics of the dropped validator to be deleted, got %d validators", count)
+	}
+
+	data.AddTx("send", 10, 0)
+	data.AddTx("send", 10, 107)
+	if value := testutil.ToFloat64(data.Economics.gasUsed.With(prometheus.Labels{"type": "send"})); value != 20 {
+		t.Fatalf("Expected gas used 20, got %f", value)
+	}
+	if value := testutil.ToFloat64(data.Economics.failedTxs.With(prometheus.Labels{"code": "107"})); value != 1 {
+		t.Fatalf("Expected 1 failed tx, got %f", value)
+	}
+}
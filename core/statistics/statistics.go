@@ -70,8 +70,9 @@ type Data struct {
 	}
 	BlockEnd blockEnd
 
-	Api  apiResponseTime
-	Peer peerPing
+	Api       apiResponseTime
+	Peer      peerPing
+	Economics *economics
 }
 type blockEnd struct {
 	sync.Mutex
@@ -128,9 +129,10 @@ func New() *Data {
 	prometheus.MustRegister(timeBlock)
 
 	return &Data{
-		Api:      apiResponseTime{responseTime: apiVec},
-		Peer:     peerPing{ping: peerVec},
-		BlockEnd: blockEnd{Height: height, Duration: lastBlockDuration, Timestamp: timeBlock},
+		Api:       apiResponseTime{responseTime: apiVec},
+		Peer:      peerPing{ping: peerVec},
+		BlockEnd:  blockEnd{Height: height, Duration: lastBlockDuration, Timestamp: timeBlock},
+		Economics: newEconomics(),
 	}
 }
 
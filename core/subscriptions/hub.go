@@ -0,0 +1,129 @@
+package subscriptions
+
+import (
+	"errors"
+	"sync"
+)
+
+// subscriptionBuffer is a number of updates which may wait for a slow subscriber
+const subscriptionBuffer = 100
+
+var (
+	ErrHeightPruned       = errors.New("updates of the height are not available")
+	ErrTooManySubscribers = errors.New("max number of subscribers reached")
+)
+
+// Hub keeps updates of recent blocks and delivers new updates to subscribers
+type Hub struct {
+	lock sync.Mutex
+
+	// from is the lowest height whose updates are either in history or not published yet
+	from        uint64
+	historySize int
+	history     []*Update
+
+	subscribers    map[*Subscription]struct{}
+	maxSubscribers int
+}
+
+// Subscription receives updates in C. C is closed if the subscriber is too slow
+// to receive updates or the subscription is closed.
+type Subscription struct {
+	C <-chan *Update
+
+	c   chan *Update
+	hub *Hub
+}
+
+// NewHub creates hub of updates published after the height, historySize is a number of blocks to keep,
+// maxSubscribers limits the number of active subscriptions
+func NewHub(height uint64, historySize int, maxSubscribers int) *Hub {
+	return &Hub{
+		from:           height + 1,
+		historySize:    historySize,
+		subscribers:    map[*Subscription]struct{}{},
+		maxSubscribers: maxSubscribers,
+	}
+}
+
+// Publish adds update of the next block to history and sends it to subscribers.
+// Subscribers which can't accept the update are dropped.
+func (h *Hub) Publish(update *Update) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.historySize > 0 {
+		h.history = append(h.history, update)
+		if len(h.history) > h.historySize {
+			h.history[0] = nil
+			h.history = h.history[1:]
+		}
+		h.from = h.history[0].Height
+	} else {
+		h.from = update.Height + 1
+	}
+
+	for sub := range h.subscribers {
+		select {
+		case sub.c <- update:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Subscribe returns a subscription to new updates and kept updates starting from fromHeight.
+// Zero fromHeight means new updates only. Returns ErrHeightPruned if some updates after fromHeight are dropped
+// and ErrTooManySubscribers if the limit of subscriptions is reached.
+func (h *Hub) Subscribe(fromHeight uint64) (*Subscription, []*Update, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if len(h.subscribers) >= h.maxSubscribers {
+		return nil, nil, ErrTooManySubscribers
+	}
+
+	if fromHeight != 0 && fromHeight < h.from {
+		return nil, nil, ErrHeightPruned
+	}
+
+	var history []*Update
+	if fromHeight != 0 {
+		for _, update := range h.history {
+			if update.Height >= fromHeight {
+				history = append(history, update)
+			}
+		}
+	}
+
+	c := make(chan *Update, subscriptionBuffer)
+	sub := &Subscription{C: c, c: c, hub: h}
+	h.subscribers[sub] = struct{}{}
+
+	return sub, history, nil
+}
+
+// NumSubscribers returns number of active subscriptions
+func (h *Hub) NumSubscribers() int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return len(h.subscribers)
+}
+
+// Close stops delivery of updates to the subscription
+func (s *Subscription) Close() {
+	s.hub.lock.Lock()
+	defer s.hub.lock.Unlock()
+
+	s.hub.remove(s)
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(h.subscribers, sub)
+	close(sub.c)
+}
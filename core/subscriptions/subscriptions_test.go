@@ -0,0 +1,115 @@
+package subscriptions
+
+import (
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	memDB := db.NewMemDB()
+	st, err := state.NewState(0, memDB, eventsdb.NewEventsStore(db.NewMemDB()), 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := types.Address{1}
+	coin := types.StrToCoinSymbol("TEST")
+	st.Coins.Create(coin, "TEST", helpers.BipToPip(big.NewInt(100)), 50, helpers.BipToPip(big.NewInt(100)), helpers.BipToPip(big.NewInt(1000)))
+	st.Accounts.AddBalance(address, coin, big.NewInt(10))
+
+	update := Collect(st, 1)
+	if update.Balances[address][coin].Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("Balance change is not collected")
+	}
+
+	if update.Coins[coin] == nil {
+		t.Fatalf("Coin change is not collected")
+	}
+
+	if price := update.Coins[coin].Price(); price.Cmp(helpers.BipToPip(big.NewInt(2))) != 0 {
+		t.Fatalf("Wrong price of the coin. Expected %s, got %s", helpers.BipToPip(big.NewInt(2)), price)
+	}
+
+	if _, err := st.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !Collect(st, 2).IsEmpty() {
+		t.Fatalf("Changes of the previous block should not be collected")
+	}
+}
+
+func TestHub(t *testing.T) {
+	hub := NewHub(10, 2, 1)
+
+	if _, _, err := hub.Subscribe(10); err != ErrHeightPruned {
+		t.Fatalf("Expected %s, got %v", ErrHeightPruned, err)
+	}
+
+	for height := uint64(11); height <= 13; height++ {
+		hub.Publish(&Update{Height: height})
+	}
+
+	if _, _, err := hub.Subscribe(11); err != ErrHeightPruned {
+		t.Fatalf("Expected %s, got %v", ErrHeightPruned, err)
+	}
+
+	sub, history, err := hub.Subscribe(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 2 || history[0].Height != 12 || history[1].Height != 13 {
+		t.Fatalf("Wrong history of updates")
+	}
+
+	if _, _, err := hub.Subscribe(0); err != ErrTooManySubscribers {
+		t.Fatalf("Expected %s, got %v", ErrTooManySubscribers, err)
+	}
+
+	hub.Publish(&Update{Height: 14})
+	if update := <-sub.C; update.Height != 14 {
+		t.Fatalf("Expected update of height 14, got %d", update.Height)
+	}
+
+	sub.Close()
+	sub.Close()
+	if _, ok := <-sub.C; ok {
+		t.Fatalf("Channel of closed subscription should be closed")
+	}
+
+	if hub.NumSubscribers() != 0 {
+		t.Fatalf("Closed subscription should be removed")
+	}
+
+	if _, _, err := hub.Subscribe(0); err != nil {
+		t.Fatalf("Subscription should be allowed after the previous one is closed: %s", err)
+	}
+}
+
+func TestHubSlowSubscriber(t *testing.T) {
+	hub := NewHub(0, 0, 1)
+
+	sub, _, err := hub.Subscribe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for height := uint64(1); height <= subscriptionBuffer+1; height++ {
+		hub.Publish(&Update{Height: height})
+	}
+
+	received := 0
+	for range sub.C {
+		received++
+	}
+
+	if received != subscriptionBuffer {
+		t.Fatalf("Expected %d updates before the subscriber is dropped, got %d", subscriptionBuffer, received)
+	}
+}
@@ -0,0 +1,86 @@
+// Package subscriptions collects changes of the state made by each block and delivers them
+// to API subscribers. Updates of recent blocks are kept in memory to let clients resume from a height.
+package subscriptions
+
+import (
+	eventsdb "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"math/big"
+)
+
+// Update describes changes of the state made by the block
+type Update struct {
+	Height uint64
+
+	// Balances contains new values of changed balances, zero value means the balance is spent
+	Balances map[types.Address]map[types.CoinSymbol]*big.Int
+
+	// Coins contains coins whose volume or reserve were changed
+	Coins map[types.CoinSymbol]*Coin
+
+	// TotalStakes contains new total stakes of candidates, in base coin
+	TotalStakes map[types.Pubkey]*big.Int
+
+	// Events contains rewards, slashes and unbonds of the block
+	Events eventsdb.Events
+
+	// Txs contains successful transactions of the block
+	Txs []Tx
+}
+
+// Tx is a successful transaction of the block with tags set by its execution
+type Tx struct {
+	Raw  []byte
+	Tags []kv.Pair
+}
+
+type Coin struct {
+	Volume  *big.Int
+	Reserve *big.Int
+	Crr     uint
+}
+
+// Price returns spot price of one coin in base coin pips, which is reserve / (volume * crr)
+func (c *Coin) Price() *big.Int {
+	if c.Volume.Sign() == 0 || c.Crr == 0 {
+		return big.NewInt(0)
+	}
+
+	price := big.NewInt(0).Mul(c.Reserve, big.NewInt(1e18))
+	price.Mul(price, big.NewInt(100))
+
+	return price.Div(price, big.NewInt(0).Mul(c.Volume, big.NewInt(int64(c.Crr))))
+}
+
+// Collect gathers changes of the state made at the height.
+// It should be called before the state is committed, events are added after they are flushed.
+func Collect(s *state.State, height uint64) *Update {
+	update := &Update{
+		Height:      height,
+		Balances:    s.Accounts.DirtyBalances(),
+		Coins:       map[types.CoinSymbol]*Coin{},
+		TotalStakes: s.Candidates.DirtyTotalStakes(),
+	}
+
+	for _, symbol := range s.Coins.DirtyCoins() {
+		coin := s.Coins.GetCoin(symbol)
+		if coin == nil {
+			continue
+		}
+
+		update.Coins[symbol] = &Coin{
+			Volume:  coin.Volume(),
+			Reserve: coin.Reserve(),
+			Crr:     coin.Crr(),
+		}
+	}
+
+	return update
+}
+
+// IsEmpty returns true if nothing was changed by the block
+func (u *Update) IsEmpty() bool {
+	return len(u.Balances) == 0 && len(u.Coins) == 0 && len(u.TotalStakes) == 0 && len(u.Events) == 0 && len(u.Txs) == 0
+}
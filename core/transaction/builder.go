@@ -0,0 +1,394 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TxDescription is a JSON description of a transaction, it lets clients build transactions without RLP encoding.
+// Data has the same format as decoded data of transactions returned by API.
+type TxDescription struct {
+	Nonce         uint64          `json:"nonce"`
+	ChainID       types.ChainID   `json:"chain_id"`
+	GasPrice      uint32          `json:"gas_price"`
+	GasCoin       string          `json:"gas_coin"`
+	Type          string          `json:"type"`
+	Data          json.RawMessage `json:"data"`
+	Payload       []byte          `json:"payload"`
+	ServiceData   []byte          `json:"service_data"`
+	SignatureType SigType         `json:"signature_type"`
+	Multisig      string          `json:"multisig"`
+
+	// From is a sender whose nonce is taken from the state by API if Nonce is 0, Multisig is used if it is empty.
+	// It is not a part of the transaction.
+	From string `json:"from"`
+}
+
+var (
+	coinSymbolType = reflect.TypeOf(types.CoinSymbol{})
+	addressType    = reflect.TypeOf(types.Address{})
+	pubkeyType     = reflect.TypeOf(types.Pubkey{})
+	bigIntType     = reflect.TypeOf(&big.Int{})
+	bytesType      = reflect.TypeOf([]byte{})
+)
+
+// Build returns unsigned transaction. Empty chain ID, gas price, gas coin and signature type are set to
+// current chain, 1, base coin and single signature. Multisig address is required for multi-signature transactions.
+func (d *TxDescription) Build() (*Transaction, error) {
+	txType, err := ParseTxType(d.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := DecodeDataJSON(txType, d.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &Transaction{
+		Nonce:         d.Nonce,
+		ChainID:       d.ChainID,
+		GasPrice:      d.GasPrice,
+		GasCoin:       types.StrToCoinSymbol(d.GasCoin),
+		Type:          txType,
+		Data:          encodedData,
+		Payload:       d.Payload,
+		ServiceData:   d.ServiceData,
+		SignatureType: d.SignatureType,
+	}
+	tx.SetDecodedData(data)
+
+	if tx.ChainID == 0 {
+		tx.ChainID = types.CurrentChainID
+	}
+	if tx.GasPrice == 0 {
+		tx.GasPrice = 1
+	}
+	if d.GasCoin == "" {
+		tx.GasCoin = types.GetBaseCoin()
+	}
+	if tx.SignatureType == 0 {
+		tx.SignatureType = SigTypeSingle
+	}
+
+	switch tx.SignatureType {
+	case SigTypeSingle:
+		if d.Multisig != "" {
+			return nil, errors.New("multisig address is set for single signature transaction")
+		}
+	case SigTypeMulti:
+		multisig, err := parseAddress(d.Multisig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multisig: %s", err)
+		}
+		tx.SetMultisigAddress(multisig)
+	default:
+		return nil, errors.New("unknown signature type")
+	}
+
+	return tx, nil
+}
+
+// Sender returns From address or multisig address if From is empty
+func (d *TxDescription) Sender() (types.Address, error) {
+	sender := d.From
+	if sender == "" {
+		sender = d.Multisig
+	}
+	if sender == "" {
+		return types.Address{}, errors.New("sender address is not set")
+	}
+
+	return parseAddress(sender)
+}
+
+// ParseTxType returns transaction type by its name, e.g. "send", or by its number
+func ParseTxType(value string) (TxType, error) {
+	for txType, name := range txTypeNames {
+		if name == value {
+			return txType, nil
+		}
+	}
+
+	number, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("unknown tx type %q", value)
+	}
+
+	if _, ok := TxDecoder.registeredTypes[TxType(number)]; !ok {
+		return 0, fmt.Errorf("tx type %d is not registered", number)
+	}
+
+	return TxType(number), nil
+}
+
+// DecodeDataJSON decodes data of the registered transaction type from JSON produced by its MarshalJSON.
+// Keys are snake case names of fields of the data, all fields except lists are required.
+func DecodeDataJSON(txType TxType, data json.RawMessage) (Data, error) {
+	d, ok := TxDecoder.registeredTypes[txType]
+	if !ok {
+		return nil, fmt.Errorf("tx type %x is not registered", byte(txType))
+	}
+
+	value := reflect.New(reflect.TypeOf(d))
+	if err := decodeJSONValue(data, value.Elem()); err != nil {
+		return nil, err
+	}
+
+	return value.Interface().(Data), nil
+}
+
+func decodeJSONValue(raw json.RawMessage, v reflect.Value) error {
+	switch v.Type() {
+	case coinSymbolType:
+		s, err := jsonString(raw)
+		if err != nil {
+			return err
+		}
+		if len(s) < 3 || len(s) > types.CoinSymbolLength {
+			return fmt.Errorf("invalid coin symbol %q", s)
+		}
+		v.Set(reflect.ValueOf(types.StrToCoinSymbol(s)))
+		return nil
+	case addressType:
+		s, err := jsonString(raw)
+		if err != nil {
+			return err
+		}
+		address, err := parseAddress(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(address))
+		return nil
+	case pubkeyType:
+		s, err := jsonString(raw)
+		if err != nil {
+			return err
+		}
+		b, err := parsePrefixedHex(s, "Mp", len(types.Pubkey{}))
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(types.BytesToPubkey(b)))
+		return nil
+	case bigIntType:
+		s, err := jsonScalar(raw)
+		if err != nil {
+			return err
+		}
+		value, ok := big.NewInt(0).SetString(s, 10)
+		if !ok || value.Sign() < 0 {
+			return fmt.Errorf("invalid amount %q", s)
+		}
+		v.Set(reflect.ValueOf(value))
+		return nil
+	case bytesType:
+		s, err := jsonString(raw)
+		if err != nil {
+			return err
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		s, err := jsonString(raw)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		s, err := jsonScalar(raw)
+		if err != nil {
+			return err
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s, err := jsonScalar(raw)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Array:
+		// fixed size byte arrays, e.g. proof of redeem check
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		s, err := jsonString(raw)
+		if err != nil {
+			return err
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		if len(b) != v.Len() {
+			return fmt.Errorf("expected %d bytes, got %d", v.Len(), len(b))
+		}
+		reflect.Copy(v, reflect.ValueOf(b))
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeJSONValue(item, slice.Index(i)); err != nil {
+				return fmt.Errorf("item %d: %s", i, err)
+			}
+		}
+		v.Set(slice)
+	case reflect.Struct:
+		return decodeJSONStruct(raw, v)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+func decodeJSONStruct(raw json.RawMessage, v reflect.Value) error {
+	var fields map[string]json.RawMessage
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := jsonFieldName(field)
+		known[name] = true
+
+		value, ok := fields[name]
+		if !ok || string(value) == "null" {
+			if field.Type.Kind() == reflect.Slice && field.Type != bytesType {
+				continue
+			}
+			return fmt.Errorf("missing field %s", name)
+		}
+
+		if err := decodeJSONValue(value, v.Field(i)); err != nil {
+			return fmt.Errorf("invalid %s: %s", name, err)
+		}
+	}
+
+	for name := range fields {
+		if !known[name] {
+			return fmt.Errorf("unknown field %s", name)
+		}
+	}
+
+	return nil
+}
+
+// jsonFieldName returns json tag of the field or its name in snake case
+func jsonFieldName(field reflect.StructField) string {
+	if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag != "" {
+		return tag
+	}
+
+	var name strings.Builder
+	for i, r := range field.Name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				name.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		name.WriteRune(r)
+	}
+
+	return name.String()
+}
+
+func jsonString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", fmt.Errorf("expected string, got %s", raw)
+	}
+
+	return s, nil
+}
+
+// jsonScalar returns string value or text of number or boolean
+func jsonScalar(raw json.RawMessage) (string, error) {
+	if len(raw) > 0 && raw[0] == '"' {
+		return jsonString(raw)
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	switch value.(type) {
+	case json.Number, bool:
+		return strings.TrimSpace(string(raw)), nil
+	}
+
+	return "", fmt.Errorf("expected scalar, got %s", raw)
+}
+
+func parseAddress(s string) (types.Address, error) {
+	b, err := parsePrefixedHex(s, "Mx", types.AddressLength)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	return types.BytesToAddress(b), nil
+}
+
+func parsePrefixedHex(s string, prefix string, length int) ([]byte, error) {
+	if !strings.HasPrefix(s, prefix) {
+		return nil, fmt.Errorf("%q should start with %s", s, prefix)
+	}
+
+	b, err := hex.DecodeString(s[len(prefix):])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) != length {
+		return nil, fmt.Errorf("expected %d bytes, got %d", length, len(b))
+	}
+
+	return b, nil
+}
@@ -0,0 +1,118 @@
+package transaction
+
+import (
+	"encoding/json"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestDecodeDataJSON(t *testing.T) {
+	coin := types.StrToCoinSymbol("TEST")
+	pubkey := types.Pubkey{1}
+	address := types.Address{2}
+	value := big.NewInt(100)
+
+	cases := map[TxType]Data{
+		TypeSend:                    &SendData{Coin: coin, To: address, Value: value},
+		TypeSellCoin:                &SellCoinData{CoinToSell: coin, ValueToSell: value, CoinToBuy: types.GetBaseCoin(), MinimumValueToBuy: value},
+		TypeSellAllCoin:             &SellAllCoinData{CoinToSell: coin, CoinToBuy: types.GetBaseCoin(), MinimumValueToBuy: value},
+		TypeBuyCoin:                 &BuyCoinData{CoinToBuy: coin, ValueToBuy: value, CoinToSell: types.GetBaseCoin(), MaximumValueToSell: value},
+		TypeCreateCoin:              &CreateCoinData{Name: "Test", Symbol: coin, InitialAmount: value, InitialReserve: value, ConstantReserveRatio: 50, MaxSupply: value},
+		TypeDeclareCandidacy:        &DeclareCandidacyData{Address: address, PubKey: pubkey, Commission: 10, Coin: coin, Stake: value},
+		TypeDelegate:                &DelegateData{PubKey: pubkey, Coin: coin, Value: value},
+		TypeUnbond:                  &UnbondData{PubKey: pubkey, Coin: coin, Value: value},
+		TypeRedeemCheck:             &RedeemCheckData{RawCheck: []byte{1, 2, 3}, Proof: [65]byte{4}},
+		TypeSetCandidateOnline:      &SetCandidateOnData{PubKey: pubkey},
+		TypeSetCandidateOffline:     &SetCandidateOffData{PubKey: pubkey},
+		TypeCreateMultisig:          &CreateMultisigData{Threshold: 2, Weights: []uint{1, 1}, Addresses: []types.Address{address, {3}}},
+		TypeMultisend:               &MultisendData{List: []MultisendDataItem{{Coin: coin, To: address, Value: value}}},
+		TypeEditCandidate:           &EditCandidateData{PubKey: pubkey, RewardAddress: address, OwnerAddress: address},
+		TypeEditCandidateCommission: &EditCandidateCommissionData{PubKey: pubkey, Commission: 20},
+		TypeEditCandidatePublicKey:  &EditCandidatePublicKeyData{PubKey: pubkey, NewPubKey: types.Pubkey{5}},
+		TypeSetDelegationPolicy:     &SetDelegationPolicyData{PubKey: pubkey, MinStake: value, AllowedCoins: []types.CoinSymbol{coin}, Private: true, Whitelist: []types.Address{address}},
+	}
+
+	for txType := range TxDecoder.registeredTypes {
+		data, ok := cases[txType]
+		if !ok {
+			t.Fatalf("No test case for tx type %s", txType.Name())
+		}
+
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := DecodeDataJSON(txType, encoded)
+		if err != nil {
+			t.Fatalf("Cannot decode data of %s: %s", txType.Name(), err)
+		}
+
+		if !reflect.DeepEqual(decoded, data) {
+			t.Fatalf("Decoded data of %s differs from the original. Expected %+v, got %+v", txType.Name(), data, decoded)
+		}
+	}
+}
+
+func TestDecodeDataJSONErrors(t *testing.T) {
+	cases := []string{
+		`{"coin":"BIP","to":"Mx0000000000000000000000000000000000000000"}`,
+		`{"coin":"BIP","to":"Mx00","value":"1"}`,
+		`{"coin":"BIP","to":"Mx0000000000000000000000000000000000000000","value":"-1"}`,
+		`{"coin":"BIP","to":"Mx0000000000000000000000000000000000000000","value":"1","memo":""}`,
+	}
+
+	for _, data := range cases {
+		if _, err := DecodeDataJSON(TypeSend, json.RawMessage(data)); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+}
+
+func TestBuildTx(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	cState.Accounts.AddBalance(addr, types.GetBaseCoin(), helpers.BipToPip(big.NewInt(1000000)))
+
+	description := &TxDescription{
+		Nonce:   1,
+		Type:    "send",
+		Data:    json.RawMessage(`{"coin":"` + types.GetBaseCoin().String() + `","to":"` + types.Address{1}.String() + `","value":"1000"}`),
+		Payload: []byte("payload"),
+	}
+
+	tx, err := description.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tx.Gas() != tx.GetDecodedData().Gas()+tx.payloadGas() {
+		t.Fatalf("Gas of built tx is not correct")
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), 0, &sync.Map{}, 0)
+	if response.Code != 0 {
+		t.Fatalf("Response code is not 0. Error %s", response.Log)
+	}
+
+	if balance := cState.Accounts.GetBalance(types.Address{1}, types.GetBaseCoin()); balance.Cmp(big.NewInt(1000)) != 0 {
+		t.Fatalf("Wrong balance of the recipient. Expected 1000, got %s", balance)
+	}
+}
@@ -73,6 +73,16 @@ func (data DeclareCandidacyData) BasicCheck(tx *Transaction, context *state.Stat
 		}
 	}
 
+	if context.Candidates.IsBlockedPubKey(data.PubKey) {
+		return &Response{
+			Code: code.PublicKeyInBlockList,
+			Log:  fmt.Sprintf("Public key (%s) was used by a candidate before and can't be used again", data.PubKey.String()),
+			Info: EncodeError(map[string]string{
+				"public_key": data.PubKey.String(),
+			}),
+		}
+	}
+
 	if data.Commission < minCommission || data.Commission > maxCommission {
 		return &Response{
 			Code: code.WrongCommission,
@@ -168,3 +168,62 @@ func TestDeclareCandidacyTxOverflow(t *testing.T) {
 		t.Fatalf("Response code is not %d. Got %d", code.TooLowStake, response.Code)
 	}
 }
+
+func TestDeclareCandidacyTxWithBlockedPubKey(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	coin := types.GetBaseCoin()
+
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pkey, _ := crypto.GenerateKey()
+	publicKeyBytes := crypto.FromECDSAPub(&pkey.PublicKey)[:32]
+	var publicKey types.Pubkey
+	copy(publicKey[:], publicKeyBytes)
+
+	cState.Candidates.BlockPubKey(publicKey)
+
+	data := DeclareCandidacyData{
+		Address:    addr,
+		PubKey:     publicKey,
+		Commission: 10,
+		Coin:       coin,
+		Stake:      helpers.BipToPip(big.NewInt(100)),
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       coin,
+		Type:          TypeDeclareCandidacy,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), 0, &sync.Map{}, 0)
+	if response.Code != code.PublicKeyInBlockList {
+		t.Fatalf("Response code is not %d. Got %d", code.PublicKeyInBlockList, response.Code)
+	}
+
+	if cState.Candidates.Exists(publicKey) {
+		t.Fatalf("Candidate with blocked public key should not be created")
+	}
+}
@@ -26,6 +26,9 @@ func init() {
 	TxDecoder.RegisterType(TypeMultisend, MultisendData{})
 	TxDecoder.RegisterType(TypeCreateMultisig, CreateMultisigData{})
 	TxDecoder.RegisterType(TypeEditCandidate, EditCandidateData{})
+	TxDecoder.RegisterType(TypeEditCandidateCommission, EditCandidateCommissionData{})
+	TxDecoder.RegisterType(TypeEditCandidatePublicKey, EditCandidatePublicKeyData{})
+	TxDecoder.RegisterType(TypeSetDelegationPolicy, SetDelegationPolicyData{})
 }
 
 type Decoder struct {
@@ -66,6 +66,10 @@ func (data DelegateData) BasicCheck(tx *Transaction, context *state.State) *Resp
 	}
 
 	sender, _ := tx.Sender()
+	if response := checkDelegationPolicy(data, sender, context); response != nil {
+		return response
+	}
+
 	if !context.Candidates.IsDelegatorStakeSufficient(sender, data.PubKey, data.Coin, data.Value) {
 		return &Response{
 			Code: code.TooLowStake,
@@ -75,6 +79,82 @@ func (data DelegateData) BasicCheck(tx *Transaction, context *state.State) *Resp
 	return nil
 }
 
+// checkDelegationPolicy checks delegation against the policy set by owner of the candidate.
+// Owner is allowed to delegate to own candidate regardless of the whitelist.
+func checkDelegationPolicy(data DelegateData, sender types.Address, context *state.State) *Response {
+	candidate := context.Candidates.GetCandidate(data.PubKey)
+	policy := candidate.GetDelegationPolicy()
+	if policy == nil {
+		return nil
+	}
+
+	if policy.Private && candidate.OwnerAddress != sender && !policy.IsWhitelisted(sender) {
+		return &Response{
+			Code: code.NotWhitelisted,
+			Log:  fmt.Sprintf("Candidate accepts delegations only from whitelisted addresses"),
+			Info: EncodeError(map[string]string{
+				"pub_key": data.PubKey.String(),
+				"sender":  sender.String(),
+			}),
+		}
+	}
+
+	if !policy.IsCoinAllowed(data.Coin) {
+		return &Response{
+			Code: code.StakeCoinNotAllowed,
+			Log:  fmt.Sprintf("Candidate does not accept stakes in coin %s", data.Coin),
+			Info: EncodeError(map[string]string{
+				"pub_key": data.PubKey.String(),
+				"coin":    data.Coin.String(),
+			}),
+		}
+	}
+
+	if policy.MinStake.Sign() == 0 {
+		return nil
+	}
+
+	if !context.Coins.Exists(data.Coin) {
+		return &Response{
+			Code: code.CoinNotExists,
+			Log:  fmt.Sprintf("Coin %s not exists", data.Coin)}
+	}
+
+	bipValue := big.NewInt(0).Set(data.Value)
+	if !data.Coin.IsBaseCoin() {
+		coin := context.Coins.GetCoin(data.Coin)
+
+		// sale return is not defined for values above the volume, and nobody can have such a balance
+		if data.Value.Cmp(coin.Volume()) == 1 {
+			return &Response{
+				Code: code.InsufficientFunds,
+				Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), data.Value, data.Coin),
+				Info: EncodeError(map[string]string{
+					"sender":       sender.String(),
+					"needed_value": data.Value.String(),
+					"coin":         fmt.Sprintf("%s", data.Coin),
+				}),
+			}
+		}
+
+		bipValue = formula.CalculateSaleReturn(coin.Volume(), coin.Reserve(), coin.Crr(), data.Value)
+	}
+
+	if bipValue.Cmp(policy.MinStake) < 0 {
+		return &Response{
+			Code: code.TooLowDelegation,
+			Log:  fmt.Sprintf("Delegation is too low. Minimal delegation is %s", policy.MinStake),
+			Info: EncodeError(map[string]string{
+				"pub_key":   data.PubKey.String(),
+				"min_stake": policy.MinStake.String(),
+				"bip_value": bipValue.String(),
+			}),
+		}
+	}
+
+	return nil
+}
+
 func (data DelegateData) String() string {
 	return fmt.Sprintf("DELEGATE pubkey:%s ",
 		hexutil.Encode(data.PubKey[:]))
@@ -0,0 +1,132 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/commissions"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/formula"
+	"github.com/tendermint/tendermint/libs/kv"
+	"math/big"
+	"strconv"
+)
+
+type EditCandidateCommissionData struct {
+	PubKey     types.Pubkey
+	Commission uint
+}
+
+func (data EditCandidateCommissionData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		PubKey     string `json:"pub_key"`
+		Commission string `json:"commission"`
+	}{
+		PubKey:     data.PubKey.String(),
+		Commission: strconv.Itoa(int(data.Commission)),
+	})
+}
+
+func (data EditCandidateCommissionData) GetPubKey() types.Pubkey {
+	return data.PubKey
+}
+
+func (data EditCandidateCommissionData) TotalSpend(tx *Transaction, context *state.State) (TotalSpends, []Conversion, *big.Int, *Response) {
+	panic("implement me")
+}
+
+func (data EditCandidateCommissionData) BasicCheck(tx *Transaction, context *state.State) *Response {
+	if data.Commission < minCommission || data.Commission > maxCommission {
+		return &Response{
+			Code: code.WrongCommission,
+			Log:  fmt.Sprintf("Commission should be between 0 and 100"),
+			Info: EncodeError(map[string]string{
+				"got_commission": fmt.Sprintf("%d", data.Commission),
+			}),
+		}
+	}
+
+	return checkCandidateOwnership(data, tx, context)
+}
+
+func (data EditCandidateCommissionData) String() string {
+	return fmt.Sprintf("EDIT CANDIDATE COMMISSION pubkey: %x commission: %d",
+		data.PubKey, data.Commission)
+}
+
+func (data EditCandidateCommissionData) Gas() int64 {
+	return commissions.EditCommission
+}
+
+func (data EditCandidateCommissionData) Run(tx *Transaction, context *state.State, isCheck bool, rewardPool *big.Int, currentBlock uint64) Response {
+	sender, _ := tx.Sender()
+
+	response := data.BasicCheck(tx, context)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.CommissionInBaseCoin()
+	commission := big.NewInt(0).Set(commissionInBaseCoin)
+
+	if !tx.GasCoin.IsBaseCoin() {
+		coin := context.Coins.GetCoin(tx.GasCoin)
+
+		errResp := CheckReserveUnderflow(coin, commissionInBaseCoin)
+		if errResp != nil {
+			return *errResp
+		}
+
+		if coin.Reserve().Cmp(commissionInBaseCoin) < 0 {
+			return Response{
+				Code: code.CoinReserveNotSufficient,
+				Log:  fmt.Sprintf("Coin reserve balance is not sufficient for transaction. Has: %s, required %s", coin.Reserve().String(), commissionInBaseCoin.String()),
+				Info: EncodeError(map[string]string{
+					"has_reserve": coin.Reserve().String(),
+					"commission":  commissionInBaseCoin.String(),
+					"gas_coin":    coin.CName,
+				}),
+			}
+		}
+
+		commission = formula.CalculateSaleAmount(coin.Volume(), coin.Reserve(), coin.Crr(), commissionInBaseCoin)
+	}
+
+	if context.Accounts.GetBalance(sender, tx.GasCoin).Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), tx.GasCoin),
+			Info: EncodeError(map[string]string{
+				"sender":       sender.String(),
+				"needed_value": commission.String(),
+				"gas_coin":     fmt.Sprintf("%s", tx.GasCoin),
+			}),
+		}
+	}
+
+	if !isCheck {
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		context.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		context.Coins.SubVolume(tx.GasCoin, commission)
+
+		context.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		context.Candidates.SetCommissionUpdate(data.PubKey, data.Commission, currentBlock+candidates.CommissionUpdateDelay)
+		context.Accounts.SetNonce(sender, tx.Nonce)
+	}
+
+	tags := kv.Pairs{
+		kv.Pair{Key: []byte("tx.type"), Value: []byte(hex.EncodeToString([]byte{byte(TypeEditCandidateCommission)}))},
+		kv.Pair{Key: []byte("tx.from"), Value: []byte(hex.EncodeToString(sender[:]))},
+	}
+
+	return Response{
+		Code:      code.OK,
+		GasUsed:   tx.Gas(),
+		GasWanted: tx.Gas(),
+		Tags:      tags,
+	}
+}
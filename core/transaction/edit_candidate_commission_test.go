@@ -0,0 +1,200 @@
+package transaction
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-go-node/upgrades"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestEditCandidateCommissionTx(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+	cState.Validators.Create(pubkey, helpers.BipToPip(big.NewInt(1)))
+
+	data := EditCandidateCommissionData{
+		PubKey:     pubkey,
+		Commission: 5,
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       coin,
+		Type:          TypeEditCandidateCommission,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	currentBlock := uint64(upgrades.UpgradeBlock4)
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), currentBlock, &sync.Map{}, 0)
+	if response.Code != 0 {
+		t.Fatalf("Response code is not 0. Error %s", response.Log)
+	}
+
+	targetBalance, _ := big.NewInt(0).SetString("999990000000000000000000", 10)
+	balance := cState.Accounts.GetBalance(addr, coin)
+	if balance.Cmp(targetBalance) != 0 {
+		t.Fatalf("Target %s balance is not correct. Expected %s, got %s", coin, targetBalance, balance)
+	}
+
+	candidate := cState.Candidates.GetCandidate(pubkey)
+	if candidate.Commission != 10 {
+		t.Fatalf("Commission should not be changed before delay. Got %d", candidate.Commission)
+	}
+
+	update := candidate.GetCommissionUpdate()
+	if update == nil {
+		t.Fatalf("Commission update not found")
+	}
+
+	activationHeight := currentBlock + candidates.CommissionUpdateDelay
+	if update.Commission != 5 || update.Height != activationHeight {
+		t.Fatalf("Wrong commission update. Expected 5 at %d, got %d at %d", activationHeight, update.Commission, update.Height)
+	}
+
+	cState.Candidates.ApplyCommissionUpdates(activationHeight - 1)
+	if candidate.Commission != 10 {
+		t.Fatalf("Commission should not be changed before delay. Got %d", candidate.Commission)
+	}
+
+	cState.Candidates.ApplyCommissionUpdates(activationHeight)
+	if candidate.Commission != 5 {
+		t.Fatalf("Commission is not changed. Expected 5, got %d", candidate.Commission)
+	}
+
+	if candidate.GetCommissionUpdate() != nil {
+		t.Fatalf("Commission update is not cleared")
+	}
+}
+
+func TestEditCandidateCommissionTxWithWrongCommission(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+
+	data := EditCandidateCommissionData{
+		PubKey:     pubkey,
+		Commission: 101,
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       coin,
+		Type:          TypeEditCandidateCommission,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), upgrades.UpgradeBlock4, &sync.Map{}, 0)
+	if response.Code != code.WrongCommission {
+		t.Fatalf("Response code is not %d. Got %d", code.WrongCommission, response.Code)
+	}
+}
+
+func TestEditCandidateCommissionTxBeforeUpgrade(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+
+	data := EditCandidateCommissionData{
+		PubKey:     pubkey,
+		Commission: 5,
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       coin,
+		Type:          TypeEditCandidateCommission,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), upgrades.UpgradeBlock4-1, &sync.Map{}, 0)
+	if response.Code != code.DecodeError {
+		t.Fatalf("Response code is not %d. Got %d", code.DecodeError, response.Code)
+	}
+
+	if cState.Candidates.GetCandidate(pubkey).GetCommissionUpdate() != nil {
+		t.Fatalf("Commission update should not be scheduled before upgrade")
+	}
+}
@@ -0,0 +1,146 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/commissions"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/formula"
+	"github.com/tendermint/tendermint/libs/kv"
+	"math/big"
+)
+
+type EditCandidatePublicKeyData struct {
+	PubKey    types.Pubkey
+	NewPubKey types.Pubkey
+}
+
+func (data EditCandidatePublicKeyData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		PubKey    string `json:"pub_key"`
+		NewPubKey string `json:"new_pub_key"`
+	}{
+		PubKey:    data.PubKey.String(),
+		NewPubKey: data.NewPubKey.String(),
+	})
+}
+
+func (data EditCandidatePublicKeyData) GetPubKey() types.Pubkey {
+	return data.PubKey
+}
+
+func (data EditCandidatePublicKeyData) TotalSpend(tx *Transaction, context *state.State) (TotalSpends, []Conversion, *big.Int, *Response) {
+	panic("implement me")
+}
+
+func (data EditCandidatePublicKeyData) BasicCheck(tx *Transaction, context *state.State) *Response {
+	if response := checkCandidateOwnership(data, tx, context); response != nil {
+		return response
+	}
+
+	if context.Candidates.Exists(data.NewPubKey) {
+		return &Response{
+			Code: code.CandidateExists,
+			Log:  fmt.Sprintf("Candidate with such public key (%s) already exists", data.NewPubKey.String()),
+			Info: EncodeError(map[string]string{
+				"public_key": data.NewPubKey.String(),
+			}),
+		}
+	}
+
+	if context.Candidates.IsBlockedPubKey(data.NewPubKey) {
+		return &Response{
+			Code: code.PublicKeyInBlockList,
+			Log:  fmt.Sprintf("Public key (%s) was used by a candidate before and can't be used again", data.NewPubKey.String()),
+			Info: EncodeError(map[string]string{
+				"public_key": data.NewPubKey.String(),
+			}),
+		}
+	}
+
+	return nil
+}
+
+func (data EditCandidatePublicKeyData) String() string {
+	return fmt.Sprintf("EDIT CANDIDATE PUBLIC KEY old: %x new: %x",
+		data.PubKey, data.NewPubKey)
+}
+
+func (data EditCandidatePublicKeyData) Gas() int64 {
+	return commissions.EditPublicKey
+}
+
+func (data EditCandidatePublicKeyData) Run(tx *Transaction, context *state.State, isCheck bool, rewardPool *big.Int, currentBlock uint64) Response {
+	sender, _ := tx.Sender()
+
+	response := data.BasicCheck(tx, context)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.CommissionInBaseCoin()
+	commission := big.NewInt(0).Set(commissionInBaseCoin)
+
+	if !tx.GasCoin.IsBaseCoin() {
+		coin := context.Coins.GetCoin(tx.GasCoin)
+
+		errResp := CheckReserveUnderflow(coin, commissionInBaseCoin)
+		if errResp != nil {
+			return *errResp
+		}
+
+		if coin.Reserve().Cmp(commissionInBaseCoin) < 0 {
+			return Response{
+				Code: code.CoinReserveNotSufficient,
+				Log:  fmt.Sprintf("Coin reserve balance is not sufficient for transaction. Has: %s, required %s", coin.Reserve().String(), commissionInBaseCoin.String()),
+				Info: EncodeError(map[string]string{
+					"has_reserve": coin.Reserve().String(),
+					"commission":  commissionInBaseCoin.String(),
+					"gas_coin":    coin.CName,
+				}),
+			}
+		}
+
+		commission = formula.CalculateSaleAmount(coin.Volume(), coin.Reserve(), coin.Crr(), commissionInBaseCoin)
+	}
+
+	if context.Accounts.GetBalance(sender, tx.GasCoin).Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), tx.GasCoin),
+			Info: EncodeError(map[string]string{
+				"sender":       sender.String(),
+				"needed_value": commission.String(),
+				"gas_coin":     fmt.Sprintf("%s", tx.GasCoin),
+			}),
+		}
+	}
+
+	if !isCheck {
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		context.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		context.Coins.SubVolume(tx.GasCoin, commission)
+
+		context.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		context.Candidates.ChangePubKey(data.PubKey, data.NewPubKey)
+		context.Validators.ChangePubKey(data.PubKey, data.NewPubKey)
+		context.FrozenFunds.ChangePubKey(data.PubKey, data.NewPubKey)
+		context.Accounts.SetNonce(sender, tx.Nonce)
+	}
+
+	tags := kv.Pairs{
+		kv.Pair{Key: []byte("tx.type"), Value: []byte(hex.EncodeToString([]byte{byte(TypeEditCandidatePublicKey)}))},
+		kv.Pair{Key: []byte("tx.from"), Value: []byte(hex.EncodeToString(sender[:]))},
+	}
+
+	return Response{
+		Code:      code.OK,
+		GasUsed:   tx.Gas(),
+		GasWanted: tx.Gas(),
+		Tags:      tags,
+	}
+}
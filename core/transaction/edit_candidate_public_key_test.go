@@ -0,0 +1,251 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-go-node/upgrades"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestEditCandidatePublicKeyTx(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	newPubkey := [32]byte{}
+	rand.Read(newPubkey[:])
+
+	stake := helpers.BipToPip(big.NewInt(100))
+	frozen := helpers.BipToPip(big.NewInt(10))
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+	cState.Candidates.Delegate(addr, pubkey, coin, stake, big.NewInt(0))
+	cState.Candidates.RecalculateStakes(109000)
+	cState.Validators.Create(pubkey, stake)
+	cState.FrozenFunds.IndexCandidates(upgrades.UpgradeBlock4)
+	cState.FrozenFunds.AddFund(upgrades.UpgradeBlock4+100, addr, pubkey, coin, frozen)
+
+	data := EditCandidatePublicKeyData{
+		PubKey:    pubkey,
+		NewPubKey: newPubkey,
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       coin,
+		Type:          TypeEditCandidatePublicKey,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), upgrades.UpgradeBlock4, &sync.Map{}, 0)
+	if response.Code != 0 {
+		t.Fatalf("Response code is not 0. Error %s", response.Log)
+	}
+
+	targetBalance, _ := big.NewInt(0).SetString("999900000000000000000000", 10)
+	balance := cState.Accounts.GetBalance(addr, coin)
+	if balance.Cmp(targetBalance) != 0 {
+		t.Fatalf("Target %s balance is not correct. Expected %s, got %s", coin, targetBalance, balance)
+	}
+
+	if cState.Candidates.Exists(pubkey) {
+		t.Fatalf("Candidate with old public key still exists")
+	}
+
+	candidate := cState.Candidates.GetCandidate(newPubkey)
+	if candidate == nil {
+		t.Fatalf("Candidate with new public key not found")
+	}
+
+	if candidate.PubKey != newPubkey {
+		t.Fatalf("Public key of candidate is not changed")
+	}
+
+	if value := cState.Candidates.GetStakeValueOfAddress(newPubkey, addr, coin); value == nil || value.Cmp(stake) != 0 {
+		t.Fatalf("Stake is not moved to the new public key")
+	}
+
+	if !cState.Validators.HasChangedPubKeys() {
+		t.Fatalf("Validators should be marked as changed")
+	}
+
+	if changes := cState.Validators.ChangedPubKeys(); len(changes) != 1 || changes[0].Old != pubkey || changes[0].New != newPubkey {
+		t.Fatalf("Change of public key of the validator is not recorded")
+	}
+
+	validator := cState.Validators.GetValidators()[0]
+	if validator.PubKey != newPubkey || validator.GetAddress() != candidate.GetTmAddress() {
+		t.Fatalf("Public key of validator is not changed")
+	}
+
+	item := cState.FrozenFunds.GetFrozenFunds(upgrades.UpgradeBlock4 + 100).List[0]
+	if *item.CandidateKey != newPubkey {
+		t.Fatalf("Public key of frozen funds is not changed")
+	}
+
+	if !cState.Candidates.IsBlockedPubKey(pubkey) {
+		t.Fatalf("Old public key is not blocked")
+	}
+}
+
+func TestEditCandidatePublicKeyTxToExistingCandidate(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+	existingPubkey := createTestCandidate(cState)
+
+	data := EditCandidatePublicKeyData{
+		PubKey:    pubkey,
+		NewPubKey: existingPubkey,
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       coin,
+		Type:          TypeEditCandidatePublicKey,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := RunTx(cState, false, encodedTx, big.NewInt(0), upgrades.UpgradeBlock4, &sync.Map{}, 0)
+	if response.Code != code.CandidateExists {
+		t.Fatalf("Response code is not %d. Got %d", code.CandidateExists, response.Code)
+	}
+}
+
+func TestEditCandidatePublicKeyTxToBlockedPubKey(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	blockedPubkey := [32]byte{}
+	rand.Read(blockedPubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+	cState.Candidates.BlockPubKey(blockedPubkey)
+
+	response := runEditCandidatePublicKeyTx(t, cState, privateKey, pubkey, blockedPubkey, upgrades.UpgradeBlock4)
+	if response.Code != code.PublicKeyInBlockList {
+		t.Fatalf("Response code is not %d. Got %d", code.PublicKeyInBlockList, response.Code)
+	}
+}
+
+func TestEditCandidatePublicKeyTxBeforeUpgrade(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	newPubkey := [32]byte{}
+	rand.Read(newPubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+
+	response := runEditCandidatePublicKeyTx(t, cState, privateKey, pubkey, newPubkey, upgrades.UpgradeBlock4-1)
+	if response.Code != code.DecodeError {
+		t.Fatalf("Response code is not %d. Got %d", code.DecodeError, response.Code)
+	}
+
+	if !cState.Candidates.Exists(pubkey) || cState.Candidates.Exists(newPubkey) {
+		t.Fatalf("Public key should not be changed before upgrade")
+	}
+}
+
+func runEditCandidatePublicKeyTx(t *testing.T, cState *state.State, privateKey *ecdsa.PrivateKey, pubkey, newPubkey types.Pubkey, currentBlock uint64) Response {
+	data := EditCandidatePublicKeyData{
+		PubKey:    pubkey,
+		NewPubKey: newPubkey,
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoin(),
+		Type:          TypeEditCandidatePublicKey,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return RunTx(cState, false, encodedTx, big.NewInt(0), currentBlock, &sync.Map{}, 0)
+}
@@ -61,6 +61,14 @@ func RunTx(context *state.State,
 		}
 	}
 
+	// transactions of types added by upgrades are treated as unknown before the upgrade
+	if !tx.Type.IsActive(currentBlock) {
+		return Response{
+			Code: code.DecodeError,
+			Log:  fmt.Sprintf("tx type %x is not registered", tx.Type),
+		}
+	}
+
 	if tx.ChainID != types.CurrentChainID {
 		return Response{
 			Code: code.WrongChainID,
@@ -0,0 +1,176 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/commissions"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/formula"
+	"github.com/tendermint/tendermint/libs/kv"
+	"math/big"
+	"strconv"
+)
+
+// maxWhitelistSize lets transaction with the whole whitelist fit maxTxLength
+// along with maximal payload and service data
+const maxWhitelistSize = 256
+
+// SetDelegationPolicyData replaces delegation policy of the candidate. Zero MinStake, empty AllowedCoins and
+// disabled Private mode remove corresponding restrictions.
+type SetDelegationPolicyData struct {
+	PubKey       types.Pubkey
+	MinStake     *big.Int
+	AllowedCoins []types.CoinSymbol
+	Private      bool
+	Whitelist    []types.Address
+}
+
+func (data SetDelegationPolicyData) MarshalJSON() ([]byte, error) {
+	allowedCoins := make([]string, len(data.AllowedCoins))
+	for i, coin := range data.AllowedCoins {
+		allowedCoins[i] = coin.String()
+	}
+
+	whitelist := make([]string, len(data.Whitelist))
+	for i, address := range data.Whitelist {
+		whitelist[i] = address.String()
+	}
+
+	return json.Marshal(struct {
+		PubKey       string   `json:"pub_key"`
+		MinStake     string   `json:"min_stake"`
+		AllowedCoins []string `json:"allowed_coins"`
+		Private      string   `json:"private"`
+		Whitelist    []string `json:"whitelist"`
+	}{
+		PubKey:       data.PubKey.String(),
+		MinStake:     data.MinStake.String(),
+		AllowedCoins: allowedCoins,
+		Private:      strconv.FormatBool(data.Private),
+		Whitelist:    whitelist,
+	})
+}
+
+func (data SetDelegationPolicyData) GetPubKey() types.Pubkey {
+	return data.PubKey
+}
+
+func (data SetDelegationPolicyData) TotalSpend(tx *Transaction, context *state.State) (TotalSpends, []Conversion, *big.Int, *Response) {
+	panic("implement me")
+}
+
+func (data SetDelegationPolicyData) BasicCheck(tx *Transaction, context *state.State) *Response {
+	if data.MinStake == nil {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data"}
+	}
+
+	for _, coin := range data.AllowedCoins {
+		if !context.Coins.Exists(coin) {
+			return &Response{
+				Code: code.CoinNotExists,
+				Log:  fmt.Sprintf("Coin %s not exists", coin)}
+		}
+	}
+
+	if len(data.Whitelist) > maxWhitelistSize {
+		return &Response{
+			Code: code.TooLargeWhitelist,
+			Log:  fmt.Sprintf("Whitelist should contain at most %d addresses", maxWhitelistSize),
+			Info: EncodeError(map[string]string{
+				"whitelist_size": strconv.Itoa(len(data.Whitelist)),
+			}),
+		}
+	}
+
+	return checkCandidateOwnership(data, tx, context)
+}
+
+func (data SetDelegationPolicyData) String() string {
+	return fmt.Sprintf("SET DELEGATION POLICY pubkey: %x min stake: %s private: %t",
+		data.PubKey, data.MinStake, data.Private)
+}
+
+func (data SetDelegationPolicyData) Gas() int64 {
+	return commissions.SetDelegationPolicy
+}
+
+func (data SetDelegationPolicyData) Run(tx *Transaction, context *state.State, isCheck bool, rewardPool *big.Int, currentBlock uint64) Response {
+	sender, _ := tx.Sender()
+
+	response := data.BasicCheck(tx, context)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.CommissionInBaseCoin()
+	commission := big.NewInt(0).Set(commissionInBaseCoin)
+
+	if !tx.GasCoin.IsBaseCoin() {
+		coin := context.Coins.GetCoin(tx.GasCoin)
+
+		errResp := CheckReserveUnderflow(coin, commissionInBaseCoin)
+		if errResp != nil {
+			return *errResp
+		}
+
+		if coin.Reserve().Cmp(commissionInBaseCoin) < 0 {
+			return Response{
+				Code: code.CoinReserveNotSufficient,
+				Log:  fmt.Sprintf("Coin reserve balance is not sufficient for transaction. Has: %s, required %s", coin.Reserve().String(), commissionInBaseCoin.String()),
+				Info: EncodeError(map[string]string{
+					"has_reserve": coin.Reserve().String(),
+					"commission":  commissionInBaseCoin.String(),
+					"gas_coin":    coin.CName,
+				}),
+			}
+		}
+
+		commission = formula.CalculateSaleAmount(coin.Volume(), coin.Reserve(), coin.Crr(), commissionInBaseCoin)
+	}
+
+	if context.Accounts.GetBalance(sender, tx.GasCoin).Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), tx.GasCoin),
+			Info: EncodeError(map[string]string{
+				"sender":       sender.String(),
+				"needed_value": commission.String(),
+				"gas_coin":     fmt.Sprintf("%s", tx.GasCoin),
+			}),
+		}
+	}
+
+	if !isCheck {
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		context.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		context.Coins.SubVolume(tx.GasCoin, commission)
+
+		context.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		context.Candidates.SetDelegationPolicy(data.PubKey, &candidates.DelegationPolicy{
+			MinStake:     data.MinStake,
+			AllowedCoins: data.AllowedCoins,
+			Private:      data.Private,
+			Whitelist:    data.Whitelist,
+		})
+		context.Accounts.SetNonce(sender, tx.Nonce)
+	}
+
+	tags := kv.Pairs{
+		kv.Pair{Key: []byte("tx.type"), Value: []byte(hex.EncodeToString([]byte{byte(TypeSetDelegationPolicy)}))},
+		kv.Pair{Key: []byte("tx.from"), Value: []byte(hex.EncodeToString(sender[:]))},
+	}
+
+	return Response{
+		Code:      code.OK,
+		GasUsed:   tx.Gas(),
+		GasWanted: tx.Gas(),
+		Tags:      tags,
+	}
+}
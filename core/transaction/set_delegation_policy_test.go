@@ -0,0 +1,315 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"github.com/MinterTeam/minter-go-node/core/code"
+	"github.com/MinterTeam/minter-go-node/core/state"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-go-node/upgrades"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestSetDelegationPolicyTx(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+
+	whitelisted := types.Address{1}
+	data := SetDelegationPolicyData{
+		PubKey:       pubkey,
+		MinStake:     helpers.BipToPip(big.NewInt(100)),
+		AllowedCoins: []types.CoinSymbol{coin},
+		Private:      true,
+		Whitelist:    []types.Address{whitelisted},
+	}
+
+	response := runSetDelegationPolicyTx(cState, privateKey, data, 1)
+	if response.Code != 0 {
+		t.Fatalf("Response code is not 0. Error %s", response.Log)
+	}
+
+	targetBalance, _ := big.NewInt(0).SetString("999990000000000000000000", 10)
+	balance := cState.Accounts.GetBalance(addr, coin)
+	if balance.Cmp(targetBalance) != 0 {
+		t.Fatalf("Target %s balance is not correct. Expected %s, got %s", coin, targetBalance, balance)
+	}
+
+	policy := cState.Candidates.GetCandidate(pubkey).GetDelegationPolicy()
+	if policy == nil {
+		t.Fatalf("Delegation policy not found")
+	}
+
+	if policy.MinStake.Cmp(data.MinStake) != 0 || !policy.Private || !policy.IsWhitelisted(whitelisted) || !policy.IsCoinAllowed(coin) {
+		t.Fatalf("Wrong delegation policy")
+	}
+
+	data = SetDelegationPolicyData{
+		PubKey:   pubkey,
+		MinStake: big.NewInt(0),
+	}
+
+	response = runSetDelegationPolicyTx(cState, privateKey, data, 2)
+	if response.Code != 0 {
+		t.Fatalf("Response code is not 0. Error %s", response.Log)
+	}
+
+	if cState.Candidates.GetCandidate(pubkey).GetDelegationPolicy() != nil {
+		t.Fatalf("Delegation policy is not removed")
+	}
+}
+
+func TestSetDelegationPolicyTxFromNotOwner(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := createTestCandidate(cState)
+
+	data := SetDelegationPolicyData{
+		PubKey:   pubkey,
+		MinStake: helpers.BipToPip(big.NewInt(100)),
+	}
+
+	response := runSetDelegationPolicyTx(cState, privateKey, data, 1)
+	if response.Code != code.IsNotOwnerOfCandidate {
+		t.Fatalf("Response code is not %d. Got %d", code.IsNotOwnerOfCandidate, response.Code)
+	}
+}
+
+func TestSetDelegationPolicyTxWithTooLargeWhitelist(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+
+	data := SetDelegationPolicyData{
+		PubKey:    pubkey,
+		MinStake:  big.NewInt(0),
+		Private:   true,
+		Whitelist: make([]types.Address, maxWhitelistSize+1),
+	}
+
+	response := runSetDelegationPolicyTx(cState, privateKey, data, 1)
+	if response.Code != code.TooLargeWhitelist {
+		t.Fatalf("Response code is not %d. Got %d", code.TooLargeWhitelist, response.Code)
+	}
+}
+
+func TestSetDelegationPolicyTxWithMaxWhitelistFitsTxLength(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+
+	data := SetDelegationPolicyData{
+		MinStake:     helpers.BipToPip(big.NewInt(1000000000000)),
+		AllowedCoins: make([]types.CoinSymbol, 16),
+		Private:      true,
+		Whitelist:    make([]types.Address, maxWhitelistSize),
+	}
+	for i := range data.Whitelist {
+		rand.Read(data.Whitelist[i][:])
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := Transaction{
+		Nonce:         1 << 60,
+		GasPrice:      1 << 30,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoin(),
+		Type:          TypeSetDelegationPolicy,
+		Data:          encodedData,
+		Payload:       make([]byte, maxPayloadLength),
+		ServiceData:   make([]byte, maxServiceDataLength),
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(encodedTx) > maxTxLength {
+		t.Fatalf("Transaction with max whitelist is %d bytes long, max %d bytes allowed", len(encodedTx), maxTxLength)
+	}
+}
+
+func TestSetDelegationPolicyTxBeforeUpgrade(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := [32]byte{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, pubkey, 10)
+
+	encodedData, err := rlp.EncodeToBytes(SetDelegationPolicyData{
+		PubKey:   pubkey,
+		MinStake: helpers.BipToPip(big.NewInt(100)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := signAndRunTxAtHeight(cState, privateKey, TypeSetDelegationPolicy, encodedData, 1, upgrades.UpgradeBlock4-1)
+	if response.Code != code.DecodeError {
+		t.Fatalf("Response code is not %d. Got %d", code.DecodeError, response.Code)
+	}
+
+	if cState.Candidates.GetCandidate(pubkey).GetDelegationPolicy() != nil {
+		t.Fatalf("Delegation policy should not be set before upgrade")
+	}
+}
+
+func TestDelegateTxWithDelegationPolicy(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	coin := types.GetBaseCoin()
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := createTestCandidate(cState)
+
+	cState.Candidates.SetDelegationPolicy(pubkey, &candidates.DelegationPolicy{
+		MinStake: helpers.BipToPip(big.NewInt(100)),
+		Private:  true,
+	})
+
+	response := runDelegateTx(cState, privateKey, pubkey, coin, helpers.BipToPip(big.NewInt(1000)), 1)
+	if response.Code != code.NotWhitelisted {
+		t.Fatalf("Response code is not %d. Got %d", code.NotWhitelisted, response.Code)
+	}
+
+	cState.Candidates.SetDelegationPolicy(pubkey, &candidates.DelegationPolicy{
+		MinStake:  helpers.BipToPip(big.NewInt(100)),
+		Private:   true,
+		Whitelist: []types.Address{addr},
+	})
+
+	response = runDelegateTx(cState, privateKey, pubkey, coin, helpers.BipToPip(big.NewInt(10)), 1)
+	if response.Code != code.TooLowDelegation {
+		t.Fatalf("Response code is not %d. Got %d", code.TooLowDelegation, response.Code)
+	}
+
+	response = runDelegateTx(cState, privateKey, pubkey, coin, helpers.BipToPip(big.NewInt(1000)), 1)
+	if response.Code != 0 {
+		t.Fatalf("Response code is not 0. Error %s", response.Log)
+	}
+
+	cState.Candidates.SetDelegationPolicy(pubkey, &candidates.DelegationPolicy{
+		MinStake:     big.NewInt(0),
+		AllowedCoins: []types.CoinSymbol{types.StrToCoinSymbol("TEST")},
+	})
+
+	response = runDelegateTx(cState, privateKey, pubkey, coin, helpers.BipToPip(big.NewInt(1000)), 2)
+	if response.Code != code.StakeCoinNotAllowed {
+		t.Fatalf("Response code is not %d. Got %d", code.StakeCoinNotAllowed, response.Code)
+	}
+}
+
+func TestDelegateTxWithDelegationPolicyAndValueAboveVolume(t *testing.T) {
+	cState := getState()
+
+	privateKey, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	cState.Accounts.AddBalance(addr, types.GetBaseCoin(), helpers.BipToPip(big.NewInt(1000000)))
+
+	coin := types.StrToCoinSymbol("TEST2")
+	volume, _, _ := createTestCoinWithSymbol(cState, coin)
+
+	pubkey := createTestCandidate(cState)
+
+	cState.Candidates.SetDelegationPolicy(pubkey, &candidates.DelegationPolicy{
+		MinStake: helpers.BipToPip(big.NewInt(100)),
+	})
+
+	value := big.NewInt(0).Mul(volume, big.NewInt(1000))
+	response := runDelegateTx(cState, privateKey, pubkey, coin, value, 1)
+	if response.Code != code.InsufficientFunds {
+		t.Fatalf("Response code is not %d. Got %d", code.InsufficientFunds, response.Code)
+	}
+}
+
+func runSetDelegationPolicyTx(cState *state.State, privateKey *ecdsa.PrivateKey, data SetDelegationPolicyData, nonce uint64) Response {
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return signAndRunTx(cState, privateKey, TypeSetDelegationPolicy, encodedData, nonce)
+}
+
+func runDelegateTx(cState *state.State, privateKey *ecdsa.PrivateKey, pubkey types.Pubkey, coin types.CoinSymbol, value *big.Int, nonce uint64) Response {
+	encodedData, err := rlp.EncodeToBytes(DelegateData{
+		PubKey: pubkey,
+		Coin:   coin,
+		Value:  value,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return signAndRunTx(cState, privateKey, TypeDelegate, encodedData, nonce)
+}
+
+func signAndRunTx(cState *state.State, privateKey *ecdsa.PrivateKey, txType TxType, data []byte, nonce uint64) Response {
+	return signAndRunTxAtHeight(cState, privateKey, txType, data, nonce, upgrades.UpgradeBlock4)
+}
+
+func signAndRunTxAtHeight(cState *state.State, privateKey *ecdsa.PrivateKey, txType TxType, data []byte, nonce uint64, currentBlock uint64) Response {
+	tx := Transaction{
+		Nonce:         nonce,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoin(),
+		Type:          txType,
+		Data:          data,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		panic(err)
+	}
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		panic(err)
+	}
+
+	return RunTx(cState, false, encodedTx, big.NewInt(0), currentBlock, &sync.Map{}, 0)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/MinterTeam/minter-go-node/crypto"
 	"github.com/MinterTeam/minter-go-node/crypto/sha3"
 	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-go-node/upgrades"
 	"math/big"
 )
 
@@ -19,20 +20,23 @@ type TxType byte
 type SigType byte
 
 const (
-	TypeSend                TxType = 0x01
-	TypeSellCoin            TxType = 0x02
-	TypeSellAllCoin         TxType = 0x03
-	TypeBuyCoin             TxType = 0x04
-	TypeCreateCoin          TxType = 0x05
-	TypeDeclareCandidacy    TxType = 0x06
-	TypeDelegate            TxType = 0x07
-	TypeUnbond              TxType = 0x08
-	TypeRedeemCheck         TxType = 0x09
-	TypeSetCandidateOnline  TxType = 0x0A
-	TypeSetCandidateOffline TxType = 0x0B
-	TypeCreateMultisig      TxType = 0x0C
-	TypeMultisend           TxType = 0x0D
-	TypeEditCandidate       TxType = 0x0E
+	TypeSend                    TxType = 0x01
+	TypeSellCoin                TxType = 0x02
+	TypeSellAllCoin             TxType = 0x03
+	TypeBuyCoin                 TxType = 0x04
+	TypeCreateCoin              TxType = 0x05
+	TypeDeclareCandidacy        TxType = 0x06
+	TypeDelegate                TxType = 0x07
+	TypeUnbond                  TxType = 0x08
+	TypeRedeemCheck             TxType = 0x09
+	TypeSetCandidateOnline      TxType = 0x0A
+	TypeSetCandidateOffline     TxType = 0x0B
+	TypeCreateMultisig          TxType = 0x0C
+	TypeMultisend               TxType = 0x0D
+	TypeEditCandidate           TxType = 0x0E
+	TypeEditCandidateCommission TxType = 0x0F
+	TypeEditCandidatePublicKey  TxType = 0x10
+	TypeSetDelegationPolicy     TxType = 0x11
 
 	SigTypeSingle SigType = 0x01
 	SigTypeMulti  SigType = 0x02
@@ -42,6 +46,47 @@ var (
 	ErrInvalidSig = errors.New("invalid transaction v, r, s values")
 )
 
+var txTypeNames = map[TxType]string{
+	TypeSend:                    "send",
+	TypeSellCoin:                "sell_coin",
+	TypeSellAllCoin:             "sell_all_coin",
+	TypeBuyCoin:                 "buy_coin",
+	TypeCreateCoin:              "create_coin",
+	TypeDeclareCandidacy:        "declare_candidacy",
+	TypeDelegate:                "delegate",
+	TypeUnbond:                  "unbond",
+	TypeRedeemCheck:             "redeem_check",
+	TypeSetCandidateOnline:      "set_candidate_online",
+	TypeSetCandidateOffline:     "set_candidate_offline",
+	TypeCreateMultisig:          "create_multisig",
+	TypeMultisend:               "multisend",
+	TypeEditCandidate:           "edit_candidate",
+	TypeEditCandidateCommission: "edit_candidate_commission",
+	TypeEditCandidatePublicKey:  "edit_candidate_public_key",
+	TypeSetDelegationPolicy:     "set_delegation_policy",
+}
+
+// txTypeUpgrades are heights from which transaction types added by network upgrades are accepted
+var txTypeUpgrades = map[TxType]uint64{
+	TypeEditCandidateCommission: upgrades.UpgradeBlock4,
+	TypeEditCandidatePublicKey:  upgrades.UpgradeBlock4,
+	TypeSetDelegationPolicy:     upgrades.UpgradeBlock4,
+}
+
+// IsActive reports whether transactions of the type are accepted at the height
+func (t TxType) IsActive(height uint64) bool {
+	return height >= txTypeUpgrades[t]
+}
+
+// Name returns name of the transaction type used by API, e.g. "send"
+func (t TxType) Name() string {
+	if name, ok := txTypeNames[t]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
 type Transaction struct {
 	Nonce         uint64
 	ChainID       types.ChainID
@@ -216,6 +261,26 @@ func (tx *Transaction) Sender() (types.Address, error) {
 	return types.Address{}, errors.New("unknown signature type")
 }
 
+// Signers recovers addresses of owners of signatures of multi-signature transaction in their order
+func (tx *Transaction) Signers() ([]types.Address, error) {
+	if tx.SignatureType != SigTypeMulti || tx.multisig == nil {
+		return nil, errors.New("transaction has no multi-signature")
+	}
+
+	txHash := tx.Hash()
+	signers := make([]types.Address, 0, len(tx.multisig.Signatures))
+	for _, sig := range tx.multisig.Signatures {
+		signer, err := RecoverPlain(txHash, sig.R, sig.S, sig.V)
+		if err != nil {
+			return nil, err
+		}
+
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
 func (tx *Transaction) Hash() types.Hash {
 	return rlpHash([]interface{}{
 		tx.Nonce,
@@ -0,0 +1,73 @@
+package transaction
+
+import (
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"math/big"
+	"testing"
+)
+
+func TestTxTypeNames(t *testing.T) {
+	for txType := range TxDecoder.registeredTypes {
+		if txType.Name() == "unknown" {
+			t.Errorf("Tx type %d has no name", txType)
+		}
+	}
+
+	if TypeSetDelegationPolicy.Name() != "set_delegation_policy" {
+		t.Fatalf("Wrong name of tx type. Expected set_delegation_policy, got %s", TypeSetDelegationPolicy.Name())
+	}
+}
+
+func TestSigners(t *testing.T) {
+	privateKey1, _ := crypto.GenerateKey()
+	privateKey2, _ := crypto.GenerateKey()
+
+	encodedData, _ := rlp.EncodeToBytes(SendData{
+		Coin:  types.GetBaseCoin(),
+		To:    types.Address{},
+		Value: big.NewInt(1),
+	})
+
+	tx := Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		GasCoin:       types.GetBaseCoin(),
+		ChainID:       types.CurrentChainID,
+		Type:          TypeSend,
+		Data:          encodedData,
+		SignatureType: SigTypeMulti,
+	}
+
+	if err := tx.Sign(privateKey1); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Sign(privateKey2); err != nil {
+		t.Fatal(err)
+	}
+	tx.SetMultisigAddress(types.Address{1})
+
+	txBytes, _ := rlp.EncodeToBytes(tx)
+	decodedTx, err := TxDecoder.DecodeFromBytes(txBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signers, err := decodedTx.Signers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []types.Address{
+		crypto.PubkeyToAddress(privateKey1.PublicKey),
+		crypto.PubkeyToAddress(privateKey2.PublicKey),
+	}
+	if len(signers) != len(expected) || signers[0] != expected[0] || signers[1] != expected[1] {
+		t.Fatalf("Wrong signers. Expected %v, got %v", expected, signers)
+	}
+
+	if sender, _ := decodedTx.Sender(); sender != (types.Address{1}) {
+		t.Fatalf("Sender of multi-signature tx should be the multisig address, got %s", sender.String())
+	}
+}
@@ -18,6 +18,12 @@ type AppState struct {
 	UsedChecks   []UsedCheck  `json:"used_checks,omitempty"`
 	MaxGas       uint64       `json:"max_gas"`
 	TotalSlashed string       `json:"total_slashed"`
+
+	// BlockListCandidates are public keys which can't be used again by candidates
+	BlockListCandidates []Pubkey `json:"block_list_candidates,omitempty"`
+
+	// PubKeyChanges are public keys replaced by candidates with the new ones, the old keys can't be used again
+	PubKeyChanges []PubKeyChange `json:"pub_key_changes,omitempty"`
 }
 
 func (s *AppState) Verify() error {
@@ -123,6 +129,19 @@ func (s *AppState) Verify() error {
 		}
 	}
 
+	blocked := append([]Pubkey{}, s.BlockListCandidates...)
+	for _, change := range s.PubKeyChanges {
+		blocked = append(blocked, change.Old)
+	}
+
+	for _, pubkey := range blocked {
+		for _, candidate := range s.Candidates {
+			if candidate.PubKey == pubkey {
+				return fmt.Errorf("candidate %s uses blocked public key", pubkey.String())
+			}
+		}
+	}
+
 	coins := map[CoinSymbol]struct{}{}
 	for _, coin := range s.Coins {
 		if coin.Symbol.IsBaseCoin() {
@@ -215,6 +234,26 @@ type Candidate struct {
 	Commission    uint    `json:"commission"`
 	Stakes        []Stake `json:"stakes"`
 	Status        byte    `json:"status"`
+
+	CommissionUpdate *CommissionUpdate `json:"commission_update,omitempty"`
+	DelegationPolicy *DelegationPolicy `json:"delegation_policy,omitempty"`
+}
+
+type CommissionUpdate struct {
+	Commission uint   `json:"commission"`
+	Height     uint64 `json:"height"`
+}
+
+type DelegationPolicy struct {
+	MinStake     string       `json:"min_stake"`
+	AllowedCoins []CoinSymbol `json:"allowed_coins,omitempty"`
+	Private      bool         `json:"private"`
+	Whitelist    []Address    `json:"whitelist,omitempty"`
+}
+
+type PubKeyChange struct {
+	Old Pubkey `json:"old"`
+	New Pubkey `json:"new"`
 }
 
 type Stake struct {
@@ -0,0 +1,331 @@
+// Package webhooks notifies HTTP endpoints about transactions and events of committed blocks.
+// Notifications are kept in a persistent queue until they are delivered or run out of attempts,
+// undelivered notifications are written to the dead letter log.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/subscriptions"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Minter-Signature"
+	DeliveryHeader  = "X-Minter-Delivery"
+
+	// pollInterval is a period of checking the queue for deliveries to retry
+	pollInterval = time.Second
+
+	maxBackoff = time.Hour
+)
+
+type endpoint struct {
+	cfg    config.WebhookConfig
+	filter *filter
+}
+
+// Dispatcher sends notifications to the endpoints. Nil dispatcher is valid and sends nothing.
+type Dispatcher struct {
+	endpoints   map[string]*endpoint
+	ordered     []*endpoint
+	maxAttempts int
+
+	client *http.Client
+	queue  *queue
+
+	deadLetterLock sync.Mutex
+	deadLetter     io.Writer
+
+	notify chan struct{}
+	now    func() time.Time
+	logger log.Logger
+}
+
+// NewDispatcher creates dispatcher of notifications to endpoints from config. Deliveries are stored in queueDB,
+// failed ones are written to deadLetter. Returns nil if there are no endpoints.
+func NewDispatcher(cfg *config.WebhooksConfig, queueDB db.DB, deadLetter io.Writer, logger log.Logger) (*Dispatcher, error) {
+	if cfg == nil || len(cfg.Endpoints) == 0 {
+		return nil, nil
+	}
+
+	q, err := newQueue(queueDB)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Dispatcher{
+		endpoints:   map[string]*endpoint{},
+		maxAttempts: cfg.MaxAttempts,
+		client:      &http.Client{Timeout: cfg.Timeout},
+		queue:       q,
+		deadLetter:  deadLetter,
+		notify:      make(chan struct{}, 1),
+		now:         time.Now,
+		logger:      logger,
+	}
+
+	for _, endpointCfg := range cfg.Endpoints {
+		if _, ok := d.endpoints[endpointCfg.URL]; ok {
+			return nil, fmt.Errorf("duplicated webhook endpoint %s", endpointCfg.URL)
+		}
+
+		f, err := newFilter(endpointCfg)
+		if err != nil {
+			return nil, fmt.Errorf("webhook endpoint %s: %s", endpointCfg.URL, err)
+		}
+
+		e := &endpoint{cfg: endpointCfg, filter: f}
+		d.endpoints[endpointCfg.URL] = e
+		d.ordered = append(d.ordered, e)
+	}
+
+	if err := d.dropRemoved(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// dropRemoved moves deliveries to endpoints which are removed from config to the dead letter log
+func (d *Dispatcher) dropRemoved() error {
+	deliveries, err := d.queue.list()
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if _, ok := d.endpoints[delivery.URL]; !ok {
+			delivery.LastError = "endpoint is removed from config"
+			d.drop(delivery)
+		}
+	}
+
+	return nil
+}
+
+// Enqueue stores notifications about the block for matching endpoints. It should be called before
+// the height is persisted, notifications of a block replayed after a crash are not stored twice.
+func (d *Dispatcher) Enqueue(update *subscriptions.Update) {
+	if d == nil || (len(update.Txs) == 0 && len(update.Events) == 0) {
+		return
+	}
+
+	if update.Height <= d.queue.height() {
+		d.logger.Info("Webhook deliveries of the block are already enqueued", "height", update.Height)
+		return
+	}
+
+	if err := d.enqueue(update); err != nil {
+		d.logger.Error("Failed to enqueue webhook deliveries", "height", update.Height, "err", err)
+	}
+}
+
+func (d *Dispatcher) enqueue(update *subscriptions.Update) error {
+	txs := make([]*Transaction, 0, len(update.Txs))
+	for _, tx := range update.Txs {
+		decoded, err := newTransaction(tx)
+		if err != nil {
+			d.logger.Error("Failed to decode transaction for webhooks", "height", update.Height, "err", err)
+			continue
+		}
+		txs = append(txs, decoded)
+	}
+
+	events := make([]*Event, 0, len(update.Events))
+	for _, event := range update.Events {
+		if e := newEvent(event); e != nil {
+			events = append(events, e)
+		}
+	}
+
+	var deliveries []*delivery
+	for _, e := range d.ordered {
+		payload := e.filter.apply(update.Height, txs, events)
+		if payload == nil {
+			continue
+		}
+
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, &delivery{
+			URL:         e.cfg.URL,
+			Height:      update.Height,
+			Payload:     data,
+			NextAttempt: d.now(),
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := d.queue.push(update.Height, deliveries); err != nil {
+		return err
+	}
+
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Run sends queued notifications until the context is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	if d == nil {
+		return
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// process sends due deliveries. After a failure the rest deliveries to the endpoint
+// are postponed until the next pass to keep their order.
+func (d *Dispatcher) process(ctx context.Context) {
+	for _, e := range d.ordered {
+		if ctx.Err() != nil {
+			return
+		}
+
+		d.processEndpoint(ctx, e)
+	}
+}
+
+// processEndpoint sends due deliveries to the endpoint in order until the first failure
+func (d *Dispatcher) processEndpoint(ctx context.Context, e *endpoint) {
+	for ctx.Err() == nil {
+		delivery, err := d.queue.first(e.cfg.URL)
+		if err != nil {
+			d.logger.Error("Failed to load webhook delivery", "url", e.cfg.URL, "err", err)
+			return
+		}
+
+		if delivery == nil || delivery.NextAttempt.After(d.now()) {
+			return
+		}
+
+		err = d.send(ctx, e, delivery)
+		if err == nil {
+			if err := d.queue.remove(delivery); err != nil {
+				d.logger.Error("Failed to remove webhook delivery", "err", err)
+				return
+			}
+			continue
+		}
+
+		// delivery interrupted by shutdown is not counted as an attempt
+		if ctx.Err() != nil {
+			return
+		}
+
+		delivery.Attempts++
+		delivery.LastError = err.Error()
+		d.logger.Info("Failed to deliver webhook", "url", delivery.URL, "height", delivery.Height, "attempt", delivery.Attempts, "err", err)
+
+		if delivery.Attempts >= d.maxAttempts {
+			d.drop(delivery)
+			return
+		}
+
+		delivery.NextAttempt = d.now().Add(backoff(delivery.Attempts))
+		if err := d.queue.update(delivery); err != nil {
+			d.logger.Error("Failed to update webhook delivery", "err", err)
+		}
+		return
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, e *endpoint, delivery *delivery) error {
+	req, err := http.NewRequest("POST", e.cfg.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, fmt.Sprintf("%d", delivery.ID))
+	req.Header.Set(SignatureHeader, Sign(e.cfg.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// drop removes the delivery from the queue and writes it to the dead letter log
+func (d *Dispatcher) drop(delivery *delivery) {
+	d.logger.Error("Webhook delivery is dropped", "url", delivery.URL, "height", delivery.Height, "err", delivery.LastError)
+
+	data, err := json.Marshal(delivery)
+	if err == nil {
+		d.deadLetterLock.Lock()
+		_, err = d.deadLetter.Write(append(data, '\n'))
+		d.deadLetterLock.Unlock()
+	}
+
+	if err != nil {
+		d.logger.Error("Failed to write webhook delivery to dead letter log", "err", err)
+		return
+	}
+
+	if err := d.queue.remove(delivery); err != nil {
+		d.logger.Error("Failed to remove webhook delivery", "err", err)
+	}
+}
+
+// Sign returns hex encoded HMAC-SHA256 of the payload
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func backoff(attempts int) time.Duration {
+	if attempts > 20 {
+		return maxBackoff
+	}
+
+	delay := time.Duration(1<<uint(attempts)) * time.Second
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,301 @@
+package webhooks
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	compact_db "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/subscriptions"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	KindTx     = "tx"
+	KindReward = "reward"
+	KindSlash  = "slash"
+	KindUnbond = "unbond"
+)
+
+// Payload is a body of notification about a committed block
+type Payload struct {
+	Height       uint64         `json:"height"`
+	Transactions []*Transaction `json:"transactions"`
+	Events       []*Event       `json:"events"`
+}
+
+type Transaction struct {
+	Hash    string            `json:"hash"`
+	From    string            `json:"from"`
+	Nonce   uint64            `json:"nonce"`
+	GasCoin string            `json:"gas_coin"`
+	Type    uint8             `json:"type"`
+	Data    json.RawMessage   `json:"data"`
+	Payload []byte            `json:"payload"`
+	Tags    map[string]string `json:"tags"`
+
+	addresses  []types.Address
+	coins      []types.CoinSymbol
+	candidates []types.Pubkey
+}
+
+type Event struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+
+	kind      string
+	address   types.Address
+	coin      *types.CoinSymbol
+	candidate types.Pubkey
+}
+
+// filter selects notifications sent to an endpoint, empty sets match everything
+type filter struct {
+	kinds      map[string]bool
+	txTypes    map[transaction.TxType]bool
+	addresses  map[types.Address]bool
+	coins      map[types.CoinSymbol]bool
+	candidates map[types.Pubkey]bool
+}
+
+func newFilter(cfg config.WebhookConfig) (*filter, error) {
+	f := &filter{
+		kinds:      map[string]bool{},
+		txTypes:    map[transaction.TxType]bool{},
+		addresses:  map[types.Address]bool{},
+		coins:      map[types.CoinSymbol]bool{},
+		candidates: map[types.Pubkey]bool{},
+	}
+
+	for _, kind := range cfg.Kinds {
+		switch kind {
+		case KindTx, KindReward, KindSlash, KindUnbond:
+			f.kinds[kind] = true
+		default:
+			return nil, fmt.Errorf("unknown kind of notifications %q", kind)
+		}
+	}
+
+	for _, txType := range cfg.TxTypes {
+		if txType <= 0 || txType > 0xff {
+			return nil, fmt.Errorf("invalid tx type %d", txType)
+		}
+		f.txTypes[transaction.TxType(txType)] = true
+	}
+
+	for _, address := range cfg.Addresses {
+		decoded, err := decodePrefixed(address, "Mx", types.AddressLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %s", address, err)
+		}
+		f.addresses[types.BytesToAddress(decoded)] = true
+	}
+
+	for _, coin := range cfg.Coins {
+		f.coins[types.StrToCoinSymbol(coin)] = true
+	}
+
+	for _, candidate := range cfg.Candidates {
+		decoded, err := decodePrefixed(candidate, "Mp", types.PubKeyLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %s", candidate, err)
+		}
+		f.candidates[types.BytesToPubkey(decoded)] = true
+	}
+
+	return f, nil
+}
+
+func decodePrefixed(value string, prefix string, length int) ([]byte, error) {
+	if len(value) != len(prefix)+length*2 || value[:len(prefix)] != prefix {
+		return nil, fmt.Errorf("should be %d bytes with %s prefix", length, prefix)
+	}
+
+	return hex.DecodeString(value[len(prefix):])
+}
+
+func (f *filter) matchTx(tx *Transaction) bool {
+	if len(f.kinds) != 0 && !f.kinds[KindTx] {
+		return false
+	}
+
+	if len(f.txTypes) != 0 && !f.txTypes[transaction.TxType(tx.Type)] {
+		return false
+	}
+
+	return f.matchAddresses(tx.addresses...) && f.matchCoins(tx.coins...) && f.matchCandidates(tx.candidates...)
+}
+
+func (f *filter) matchEvent(event *Event) bool {
+	if len(f.kinds) != 0 && !f.kinds[event.kind] {
+		return false
+	}
+
+	if event.coin != nil && !f.matchCoins(*event.coin) {
+		return false
+	}
+
+	// rewards are paid in base coin
+	if event.coin == nil && len(f.coins) != 0 && !f.coins[types.GetBaseCoin()] {
+		return false
+	}
+
+	return f.matchAddresses(event.address) && f.matchCandidates(event.candidate)
+}
+
+func (f *filter) matchAddresses(addresses ...types.Address) bool {
+	if len(f.addresses) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		if f.addresses[address] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *filter) matchCoins(coins ...types.CoinSymbol) bool {
+	if len(f.coins) == 0 {
+		return true
+	}
+
+	for _, coin := range coins {
+		if f.coins[coin] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *filter) matchCandidates(candidates ...types.Pubkey) bool {
+	if len(f.candidates) == 0 {
+		return true
+	}
+
+	for _, candidate := range candidates {
+		if f.candidates[candidate] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apply returns payload with transactions and events matching the filter, nil if there are none
+func (f *filter) apply(height uint64, txs []*Transaction, events []*Event) *Payload {
+	payload := &Payload{Height: height, Transactions: []*Transaction{}, Events: []*Event{}}
+
+	for _, tx := range txs {
+		if f.matchTx(tx) {
+			payload.Transactions = append(payload.Transactions, tx)
+		}
+	}
+
+	for _, event := range events {
+		if f.matchEvent(event) {
+			payload.Events = append(payload.Events, event)
+		}
+	}
+
+	if len(payload.Transactions) == 0 && len(payload.Events) == 0 {
+		return nil
+	}
+
+	return payload
+}
+
+// newTransaction decodes successful transaction of the block and collects addresses, coins and candidates it touches
+func newTransaction(tx subscriptions.Tx) (*Transaction, error) {
+	decodedTx, err := transaction.TxDecoder.DecodeFromBytes(tx.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := decodedTx.Sender()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(decodedTx.GetDecodedData())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Transaction{
+		Hash:      fmt.Sprintf("Mt%x", tmTypes.Tx(tx.Raw).Hash()),
+		From:      sender.String(),
+		Nonce:     decodedTx.Nonce,
+		GasCoin:   decodedTx.GasCoin.String(),
+		Type:      uint8(decodedTx.Type),
+		Data:      data,
+		Payload:   decodedTx.Payload,
+		Tags:      map[string]string{},
+		addresses: []types.Address{sender},
+		coins:     []types.CoinSymbol{decodedTx.GasCoin},
+	}
+
+	for _, tag := range tx.Tags {
+		result.Tags[string(tag.Key)] = string(tag.Value)
+	}
+
+	switch data := decodedTx.GetDecodedData().(type) {
+	case *transaction.SendData:
+		result.addresses = append(result.addresses, data.To)
+		result.coins = append(result.coins, data.Coin)
+	case *transaction.MultisendData:
+		for _, item := range data.List {
+			result.addresses = append(result.addresses, item.To)
+			result.coins = append(result.coins, item.Coin)
+		}
+	case *transaction.SellCoinData:
+		result.coins = append(result.coins, data.CoinToSell, data.CoinToBuy)
+	case *transaction.SellAllCoinData:
+		result.coins = append(result.coins, data.CoinToSell, data.CoinToBuy)
+	case *transaction.BuyCoinData:
+		result.coins = append(result.coins, data.CoinToSell, data.CoinToBuy)
+	case *transaction.CreateCoinData:
+		result.coins = append(result.coins, data.Symbol)
+	case *transaction.DeclareCandidacyData:
+		result.addresses = append(result.addresses, data.Address)
+		result.coins = append(result.coins, data.Coin)
+		result.candidates = append(result.candidates, data.PubKey)
+	case *transaction.DelegateData:
+		result.coins = append(result.coins, data.Coin)
+		result.candidates = append(result.candidates, data.PubKey)
+	case *transaction.UnbondData:
+		result.coins = append(result.coins, data.Coin)
+		result.candidates = append(result.candidates, data.PubKey)
+	case *transaction.EditCandidateData:
+		result.addresses = append(result.addresses, data.RewardAddress, data.OwnerAddress)
+		result.candidates = append(result.candidates, data.PubKey)
+	case interface{ GetPubKey() types.Pubkey }:
+		result.candidates = append(result.candidates, data.GetPubKey())
+	}
+
+	if multisig, ok := result.Tags["tx.created_multisig"]; ok {
+		if decoded, err := hex.DecodeString(multisig); err == nil {
+			result.addresses = append(result.addresses, types.BytesToAddress(decoded))
+		}
+	}
+
+	return result, nil
+}
+
+func newEvent(event compact_db.Event) *Event {
+	switch e := event.(type) {
+	case *compact_db.RewardEvent:
+		return &Event{Type: "minter/RewardEvent", Value: e, kind: KindReward, address: e.Address, candidate: e.ValidatorPubKey}
+	case *compact_db.SlashEvent:
+		return &Event{Type: "minter/SlashEvent", Value: e, kind: KindSlash, address: e.Address, coin: &e.Coin, candidate: e.ValidatorPubKey}
+	case *compact_db.UnbondEvent:
+		return &Event{Type: "minter/UnbondEvent", Value: e, kind: KindUnbond, address: e.Address, coin: &e.Coin, candidate: e.ValidatorPubKey}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package webhooks
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	db "github.com/tendermint/tm-db"
+	"sync"
+	"time"
+)
+
+// delivery is a notification waiting to be sent to an endpoint
+type delivery struct {
+	ID          uint64          `json:"id"`
+	URL         string          `json:"url"`
+	Height      uint64          `json:"height"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt time.Time       `json:"next_attempt"`
+	LastError   string          `json:"last_error,omitempty"`
+}
+
+var (
+	lastIDKey     = []byte("mi")
+	lastHeightKey = []byte("mh")
+
+	deliveryPrefix = byte('d')
+)
+
+// queue keeps deliveries in the database, so they survive restarts of the node.
+// Deliveries are grouped by endpoints and ordered by id within a group,
+// so only the first delivery of an endpoint is read to check if it is due.
+type queue struct {
+	db db.DB
+
+	lock       sync.Mutex
+	lastID     uint64
+	lastHeight uint64
+}
+
+func newQueue(db db.DB) (*queue, error) {
+	q := &queue{db: db}
+
+	var err error
+	if q.lastID, err = q.getUint64(lastIDKey); err != nil {
+		return nil, err
+	}
+
+	if q.lastHeight, err = q.getUint64(lastHeightKey); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (q *queue) getUint64(key []byte) (uint64, error) {
+	value, err := q.db.Get(key)
+	if err != nil || len(value) != 8 {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(value), nil
+}
+
+// height returns the last height whose deliveries are stored
+func (q *queue) height() uint64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.lastHeight
+}
+
+// push adds deliveries of the block at height to the queue atomically and assigns ids to them
+func (q *queue) push(height uint64, deliveries []*delivery) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	batch := q.db.NewBatch()
+	defer batch.Close()
+
+	lastID := q.lastID
+	for _, d := range deliveries {
+		lastID++
+		d.ID = lastID
+
+		data, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		batch.Set(deliveryKey(d.URL, d.ID), data)
+	}
+
+	batch.Set(lastIDKey, uint64Bytes(lastID))
+	batch.Set(lastHeightKey, uint64Bytes(height))
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	q.lastID = lastID
+	q.lastHeight = height
+	return nil
+}
+
+// first returns the oldest delivery to the endpoint, nil if there are no deliveries
+func (q *queue) first(url string) (*delivery, error) {
+	prefix := endpointPrefix(url)
+	it, err := q.db.Iterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, nil
+	}
+
+	d := new(delivery)
+	if err := json.Unmarshal(it.Value(), d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// list returns all deliveries ordered by endpoints and ids
+func (q *queue) list() ([]*delivery, error) {
+	prefix := []byte{deliveryPrefix}
+	it, err := q.db.Iterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var result []*delivery
+	for ; it.Valid(); it.Next() {
+		d := new(delivery)
+		if err := json.Unmarshal(it.Value(), d); err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+
+	return result, nil
+}
+
+func (q *queue) update(d *delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	return q.db.SetSync(deliveryKey(d.URL, d.ID), data)
+}
+
+func (q *queue) remove(d *delivery) error {
+	return q.db.DeleteSync(deliveryKey(d.URL, d.ID))
+}
+
+func endpointPrefix(url string) []byte {
+	hash := sha256.Sum256([]byte(url))
+	return append([]byte{deliveryPrefix}, hash[:]...)
+}
+
+func deliveryKey(url string, id uint64) []byte {
+	return append(endpointPrefix(url), uint64Bytes(id)...)
+}
+
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+
+	return nil
+}
+
+func uint64Bytes(value uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, value)
+	return b
+}
@@ -0,0 +1,273 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	compact_db "github.com/MinterTeam/events-db"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/subscriptions"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type receiver struct {
+	lock     sync.Mutex
+	status   int
+	payloads []*Payload
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func newReceiver() (*receiver, *httptest.Server) {
+	r := &receiver{status: http.StatusOK}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		if r.status != http.StatusOK {
+			w.WriteHeader(r.status)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		payload := new(Payload)
+		_ = json.Unmarshal(body, payload)
+
+		r.payloads = append(r.payloads, payload)
+		r.bodies = append(r.bodies, body)
+		r.headers = append(r.headers, req.Header)
+	}))
+
+	return r, server
+}
+
+func makeSendTx(t *testing.T, to types.Address) subscriptions.Tx {
+	privateKey, _ := crypto.GenerateKey()
+
+	data, err := rlp.EncodeToBytes(transaction.SendData{Coin: types.GetBaseCoin(), To: to, Value: big.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := transaction.Transaction{
+		Nonce:         1,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoin(),
+		Type:          transaction.TypeSend,
+		Data:          data,
+		SignatureType: transaction.SigTypeSingle,
+	}
+	if err := tx.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return subscriptions.Tx{Raw: raw}
+}
+
+func TestDispatcher(t *testing.T) {
+	deposits, depositsServer := newReceiver()
+	defer depositsServer.Close()
+
+	rewards, rewardsServer := newReceiver()
+	defer rewardsServer.Close()
+
+	wallet := types.Address{1}
+	validator := types.Pubkey{2}
+
+	d, err := NewDispatcher(&config.WebhooksConfig{
+		MaxAttempts: 3,
+		Timeout:     time.Second,
+		Endpoints: []config.WebhookConfig{
+			{URL: depositsServer.URL, Secret: "secret", Kinds: []string{KindTx}, Addresses: []string{wallet.String()}},
+			{URL: rewardsServer.URL, Kinds: []string{KindReward}, Candidates: []string{validator.String()}},
+		},
+	}, db.NewMemDB(), &bytes.Buffer{}, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.Enqueue(&subscriptions.Update{
+		Height: 10,
+		Txs:    []subscriptions.Tx{makeSendTx(t, wallet), makeSendTx(t, types.Address{3})},
+		Events: compact_db.Events{
+			&compact_db.RewardEvent{Role: "Validator", Address: wallet, Amount: "100", ValidatorPubKey: validator},
+			&compact_db.RewardEvent{Role: "Validator", Address: wallet, Amount: "100", ValidatorPubKey: types.Pubkey{4}},
+		},
+	})
+	d.process(context.Background())
+
+	if len(deposits.payloads) != 1 || len(deposits.payloads[0].Transactions) != 1 || len(deposits.payloads[0].Events) != 0 {
+		t.Fatalf("Endpoint should receive only the transaction to the wallet")
+	}
+
+	if deposits.payloads[0].Height != 10 {
+		t.Fatalf("Wrong height of the payload. Expected 10, got %d", deposits.payloads[0].Height)
+	}
+
+	if signature := deposits.headers[0].Get(SignatureHeader); signature != Sign("secret", deposits.bodies[0]) {
+		t.Fatalf("Wrong signature of the payload")
+	}
+
+	if len(rewards.payloads) != 1 || len(rewards.payloads[0].Transactions) != 0 || len(rewards.payloads[0].Events) != 1 {
+		t.Fatalf("Endpoint should receive only the reward of the candidate")
+	}
+
+	if deliveries, _ := d.queue.list(); len(deliveries) != 0 {
+		t.Fatalf("Delivered notifications should be removed from the queue")
+	}
+}
+
+func TestDispatcherRetry(t *testing.T) {
+	r, server := newReceiver()
+	defer server.Close()
+	r.status = http.StatusInternalServerError
+
+	queueDB := db.NewMemDB()
+	deadLetter := &bytes.Buffer{}
+	cfg := &config.WebhooksConfig{
+		MaxAttempts: 2,
+		Timeout:     time.Second,
+		Endpoints:   []config.WebhookConfig{{URL: server.URL}},
+	}
+
+	d, err := NewDispatcher(cfg, queueDB, deadLetter, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(0, 0)
+	d.now = func() time.Time {
+		return now
+	}
+
+	d.Enqueue(&subscriptions.Update{Height: 1, Txs: []subscriptions.Tx{makeSendTx(t, types.Address{})}})
+	d.Enqueue(&subscriptions.Update{Height: 2, Txs: []subscriptions.Tx{makeSendTx(t, types.Address{})}})
+	d.process(context.Background())
+
+	// queue is persistent, new dispatcher continues delivery
+	d, err = NewDispatcher(cfg, queueDB, deadLetter, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.now = func() time.Time {
+		return now
+	}
+
+	deliveries, _ := d.queue.list()
+	if len(deliveries) != 2 || deliveries[0].Attempts != 1 || deliveries[1].Attempts != 0 {
+		t.Fatalf("Failed delivery should be kept in the queue, the next one should wait for it")
+	}
+
+	d.process(context.Background())
+	if deliveries, _ := d.queue.list(); deliveries[0].Attempts != 1 {
+		t.Fatalf("Delivery should not be retried before backoff")
+	}
+
+	now = now.Add(time.Hour)
+	d.process(context.Background())
+
+	deliveries, _ = d.queue.list()
+	if len(deliveries) != 1 || deliveries[0].Height != 2 {
+		t.Fatalf("Delivery should be dropped after max attempts")
+	}
+
+	dropped := new(delivery)
+	if err := json.Unmarshal(deadLetter.Bytes(), dropped); err != nil {
+		t.Fatalf("Dropped delivery should be written to dead letter log: %s", err)
+	}
+
+	if dropped.Height != 1 || dropped.Attempts != 2 {
+		t.Fatalf("Wrong delivery in dead letter log")
+	}
+
+	r.status = http.StatusOK
+	now = now.Add(time.Hour)
+	d.process(context.Background())
+
+	if len(r.payloads) != 1 || r.payloads[0].Height != 2 {
+		t.Fatalf("Delivery should succeed after endpoint is recovered")
+	}
+
+	d.Enqueue(&subscriptions.Update{Height: 3, Txs: []subscriptions.Tx{makeSendTx(t, types.Address{})}})
+	if deliveries, _ := d.queue.list(); len(deliveries) != 1 || deliveries[0].ID != 3 {
+		t.Fatalf("Ids of deliveries should not be reused")
+	}
+}
+
+func TestDispatcherRestart(t *testing.T) {
+	queueDB := db.NewMemDB()
+	deadLetter := &bytes.Buffer{}
+	cfg := &config.WebhooksConfig{
+		MaxAttempts: 2,
+		Timeout:     time.Second,
+		Endpoints:   []config.WebhookConfig{{URL: "http://localhost:1"}, {URL: "http://localhost:2"}},
+	}
+
+	d, err := NewDispatcher(cfg, queueDB, deadLetter, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.Enqueue(&subscriptions.Update{Height: 1, Txs: []subscriptions.Tx{makeSendTx(t, types.Address{})}})
+
+	// block replayed after a crash
+	d, err = NewDispatcher(cfg, queueDB, deadLetter, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.Enqueue(&subscriptions.Update{Height: 1, Txs: []subscriptions.Tx{makeSendTx(t, types.Address{})}})
+	if deliveries, _ := d.queue.list(); len(deliveries) != 2 {
+		t.Fatalf("Deliveries of replayed block should not be enqueued twice, got %d deliveries", len(deliveries))
+	}
+
+	cfg.Endpoints = cfg.Endpoints[:1]
+	d, err = NewDispatcher(cfg, queueDB, deadLetter, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deliveries, _ := d.queue.list()
+	if len(deliveries) != 1 || deliveries[0].URL != "http://localhost:1" {
+		t.Fatalf("Deliveries to removed endpoint should be dropped")
+	}
+
+	dropped := new(delivery)
+	if err := json.Unmarshal(deadLetter.Bytes(), dropped); err != nil || dropped.URL != "http://localhost:2" {
+		t.Fatalf("Delivery to removed endpoint should be written to dead letter log")
+	}
+}
+
+func TestNewDispatcherInvalidConfig(t *testing.T) {
+	cases := []config.WebhookConfig{
+		{URL: "http://localhost", Kinds: []string{"unknown"}},
+		{URL: "http://localhost", TxTypes: []int{256}},
+		{URL: "http://localhost", Addresses: []string{"Mx01"}},
+		{URL: "http://localhost", Candidates: []string{"Mx0000000000000000000000000000000000000000"}},
+	}
+
+	for _, endpoint := range cases {
+		_, err := NewDispatcher(&config.WebhooksConfig{Endpoints: []config.WebhookConfig{endpoint}}, db.NewMemDB(), &bytes.Buffer{}, log.NewNopLogger())
+		if err == nil {
+			t.Errorf("Expected error for %+v", endpoint)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+// Package mintertest runs a single validator Minter chain in memory for integration tests.
+// State, events and Tendermint databases are kept in memory, a temporary directory holds only
+// the consensus WAL and the address book. Node and API v1 use package level state,
+// so only one node may run in a process at a time.
+package mintertest
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/MinterTeam/minter-go-node/api"
+	"github.com/MinterTeam/minter-go-node/api/limiter"
+	"github.com/MinterTeam/minter-go-node/api/v2/service"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/minter"
+	"github.com/MinterTeam/minter-go-node/core/state/candidates"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/MinterTeam/minter-go-node/rpc/lib/types"
+	"github.com/MinterTeam/minter-go-node/version"
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmNode "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	chainID = "minter-test-network"
+
+	defaultBlockTime = 50 * time.Millisecond
+
+	// waitTimeout limits waiting for blocks
+	waitTimeout = 10 * time.Second
+)
+
+var (
+	// DefaultBalance is a balance of the account of Node.PrivateKey in the default genesis
+	DefaultBalance = helpers.BipToPip(big.NewInt(1000000))
+
+	// ValidatorStake is a stake of the validator of the node
+	ValidatorStake = helpers.BipToPip(big.NewInt(1000000))
+)
+
+type Options struct {
+	// AppState is the genesis state, the validator of the node is appended to its validators and candidates.
+	// The default state has only the account of Node.PrivateKey with DefaultBalance.
+	AppState *types.AppState
+
+	// PrivateKey of the account owning the validator, generated if nil
+	PrivateKey *ecdsa.PrivateKey
+
+	// BlockTime is a minimal time between blocks, 50ms by default
+	BlockTime time.Duration
+
+	// Logger of the node, logs are discarded by default
+	Logger log.Logger
+}
+
+// Node is a running single validator chain
+type Node struct {
+	App        *minter.Blockchain
+	Client     *rpc.Local
+	Tendermint *tmNode.Node
+
+	// API2 is the service of API v2, its methods can be called directly
+	API2 *service.Service
+
+	// PrivateKey of the account owning the validator
+	PrivateKey *ecdsa.PrivateKey
+	Validator  types.Pubkey
+
+	api1 *httptest.Server
+	dir  string
+}
+
+// New starts the node and waits for the first block
+func New(opts Options) (*Node, error) {
+	if opts.PrivateKey == nil {
+		privateKey, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		opts.PrivateKey = privateKey
+	}
+
+	if opts.BlockTime == 0 {
+		opts.BlockTime = defaultBlockTime
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+
+	dir, err := ioutil.TempDir("", "mintertest")
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := start(dir, opts)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func start(dir string, opts Options) (*Node, error) {
+	if err := tmos.EnsureDir(filepath.Join(dir, "config"), 0700); err != nil {
+		return nil, err
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.SetRoot(dir)
+	cfg.DBBackend = "memdb"
+	cfg.P2P.AddrBook = "config/addrbook.json"
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	cfg.P2P.Seeds = ""
+	cfg.P2P.PersistentPeers = ""
+	cfg.RPC.ListenAddress = ""
+	cfg.Consensus.TimeoutCommit = opts.BlockTime
+	cfg.Consensus.SkipTimeoutCommit = false
+	cfg.HealthMinPeers = 0
+
+	pv := tmTypes.NewMockPV()
+	pubkey := pv.GetPubKey().(ed25519.PubKeyEd25519)
+	var validator types.Pubkey
+	copy(validator[:], pubkey[:])
+
+	genesis, err := makeGenesis(opts, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	app := minter.NewMinterBlockchain(cfg)
+	tmConfig := config.GetTmConfig(cfg)
+
+	node, err := tmNode.NewNode(
+		tmConfig,
+		pv,
+		&p2p.NodeKey{PrivKey: ed25519.GenPrivKey()},
+		proxy.NewLocalClientCreator(app),
+		func() (*tmTypes.GenesisDoc, error) { return genesis, nil },
+		tmNode.DefaultDBProvider,
+		tmNode.DefaultMetricsProvider(tmConfig.Instrumentation),
+		opts.Logger.With("module", "tendermint"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a node: %s", err)
+	}
+
+	if err := node.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start node: %s", err)
+	}
+
+	app.SetTmNode(node)
+	client := rpc.NewLocal(node)
+
+	n := &Node{
+		App:        app,
+		Client:     client,
+		Tendermint: node,
+		API2:       service.NewService(amino.NewCodec(), app, client, node, cfg, version.Version),
+		PrivateKey: opts.PrivateKey,
+		Validator:  validator,
+		api1:       httptest.NewServer(api.NewHandler(app, client, cfg, limiter.NewLimiter(cfg.APIGateway), opts.Logger)),
+		dir:        dir,
+	}
+
+	if err := n.WaitForHeight(1); err != nil {
+		n.Stop()
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// makeGenesis appends the validator owned by the account of opts.PrivateKey to the genesis state
+func makeGenesis(opts Options, validator types.Pubkey) (*tmTypes.GenesisDoc, error) {
+	owner := crypto.PubkeyToAddress(opts.PrivateKey.PublicKey)
+
+	var appState types.AppState
+	if opts.AppState != nil {
+		appState = *opts.AppState
+	} else {
+		appState.Accounts = []types.Account{
+			{
+				Address: owner,
+				Balance: []types.Balance{
+					{
+						Coin:  types.GetBaseCoin(),
+						Value: DefaultBalance.String(),
+					},
+				},
+			},
+		}
+	}
+
+	if appState.TotalSlashed == "" {
+		appState.TotalSlashed = "0"
+	}
+
+	appState.Validators = append(appState.Validators, types.Validator{
+		TotalBipStake: ValidatorStake.String(),
+		PubKey:        validator,
+		AccumReward:   "0",
+		AbsentTimes:   types.NewBitArray(24),
+	})
+	appState.Candidates = append(appState.Candidates, types.Candidate{
+		RewardAddress: owner,
+		OwnerAddress:  owner,
+		TotalBipStake: ValidatorStake.String(),
+		PubKey:        validator,
+		Commission:    100,
+		Stakes: []types.Stake{
+			{
+				Owner:    owner,
+				Coin:     types.GetBaseCoin(),
+				Value:    ValidatorStake.String(),
+				BipValue: ValidatorStake.String(),
+			},
+		},
+		Status: candidates.CandidateStatusOnline,
+	})
+
+	appStateJSON, err := amino.MarshalJSON(appState)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := &tmTypes.GenesisDoc{
+		ChainID:     chainID,
+		GenesisTime: time.Now(),
+		AppHash:     make([]byte, 32),
+		AppState:    json.RawMessage(appStateJSON),
+	}
+
+	if err := genesis.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// Stop stops the node and removes its temporary directory
+func (n *Node) Stop() {
+	n.api1.Close()
+	_ = n.Tendermint.Stop()
+	n.Tendermint.Wait()
+	n.App.Stop()
+	_ = os.RemoveAll(n.dir)
+}
+
+// Address returns the address of the account owning the validator
+func (n *Node) Address() types.Address {
+	return crypto.PubkeyToAddress(n.PrivateKey.PublicKey)
+}
+
+// Height returns the height of the last committed block
+func (n *Node) Height() uint64 {
+	return n.App.Height()
+}
+
+// WaitForHeight waits until the block at the height is committed
+func (n *Node) WaitForHeight(height uint64) error {
+	timeout := time.After(waitTimeout)
+	for n.Height() < height {
+		select {
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for block %d, current height is %d", height, n.Height())
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	return nil
+}
+
+// AdvanceBlocks waits until the given number of blocks is committed
+func (n *Node) AdvanceBlocks(count uint64) error {
+	return n.WaitForHeight(n.Height() + count)
+}
+
+// SignTx signs the transaction of the given type with the next nonce of the sender,
+// gas is paid in base coin. Data should be one of the data types of core/transaction.
+func (n *Node) SignTx(privateKey *ecdsa.PrivateKey, txType transaction.TxType, data interface{}) ([]byte, error) {
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cState := n.App.CurrentState()
+	cState.RLock()
+	nonce := cState.Accounts.GetNonce(crypto.PubkeyToAddress(privateKey.PublicKey)) + 1
+	cState.RUnlock()
+
+	tx := transaction.Transaction{
+		Nonce:         nonce,
+		ChainID:       types.CurrentChainID,
+		GasPrice:      1,
+		GasCoin:       types.GetBaseCoin(),
+		Type:          txType,
+		Data:          encodedData,
+		SignatureType: transaction.SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return tx.Serialize()
+}
+
+// SendTx signs the transaction, sends it and waits until its block is committed
+func (n *Node) SendTx(privateKey *ecdsa.PrivateKey, txType transaction.TxType, data interface{}) (*ctypes.ResultBroadcastTxCommit, error) {
+	tx, err := n.SignTx(privateKey, txType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.BroadcastTx(tx)
+}
+
+// BroadcastTx sends the signed transaction and waits until its block is committed,
+// an error is returned if the transaction is rejected or failed
+func (n *Node) BroadcastTx(tx []byte) (*ctypes.ResultBroadcastTxCommit, error) {
+	result, err := n.Client.BroadcastTxCommit(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.CheckTx.Code != 0 {
+		return result, fmt.Errorf("check tx failed with code %d: %s", result.CheckTx.Code, result.CheckTx.Log)
+	}
+
+	if result.DeliverTx.Code != 0 {
+		return result, fmt.Errorf("deliver tx failed with code %d: %s", result.DeliverTx.Code, result.DeliverTx.Log)
+	}
+
+	// the tx is delivered before the block is committed to the state
+	return result, n.WaitForHeight(uint64(result.Height))
+}
+
+// API1URL returns the address of API v1 served by the node
+func (n *Node) API1URL() string {
+	return n.api1.URL
+}
+
+// CallAPI1 calls the method of API v1 and decodes its result into the result
+func (n *Node) CallAPI1(method string, params url.Values, result interface{}) error {
+	resp, err := http.Get(n.api1.URL + "/" + method + "?" + params.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var response rpctypes.RPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return err
+	}
+
+	if response.Error != nil {
+		return response.Error
+	}
+
+	if response.Result == nil {
+		return errors.New("empty result")
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
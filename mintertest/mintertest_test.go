@@ -0,0 +1,120 @@
+package mintertest
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/core/health"
+	"github.com/MinterTeam/minter-go-node/core/transaction"
+	"github.com/MinterTeam/minter-go-node/core/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	pb "github.com/MinterTeam/node-grpc-gateway/api_pb"
+	"math/big"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestNode(t *testing.T) {
+	recipient, _ := crypto.GenerateKey()
+	recipientAddress := crypto.PubkeyToAddress(recipient.PublicKey)
+
+	n, err := New(Options{
+		AppState: &types.AppState{
+			Accounts: []types.Account{
+				{
+					Address: recipientAddress,
+					Balance: []types.Balance{{Coin: types.GetBaseCoin(), Value: "1000000000000000000"}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Stop()
+
+	if _, err := n.SendTx(recipient, transaction.TypeSend, transaction.SendData{
+		Coin:  types.GetBaseCoin(),
+		To:    types.Address{1},
+		Value: big.NewInt(100),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var address struct {
+		Balance map[string]string `json:"balance"`
+	}
+	if err := n.CallAPI1("address", url.Values{"address": {types.Address{1}.String()}}, &address); err != nil {
+		t.Fatal(err)
+	}
+
+	if address.Balance[types.GetBaseCoin().String()] != "100" {
+		t.Fatalf("Wrong balance of the recipient in API v1: %v", address.Balance)
+	}
+
+	response, err := n.API2.Address(context.Background(), &pb.AddressRequest{Address: recipientAddress.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.TransactionsCount != "1" {
+		t.Fatalf("Wrong nonce of the sender in API v2: %s", response.TransactionsCount)
+	}
+
+	height := n.Height()
+	if err := n.AdvanceBlocks(2); err != nil {
+		t.Fatal(err)
+	}
+
+	if n.Height() < height+2 {
+		t.Fatalf("Blocks are not advanced")
+	}
+
+	if _, err := n.SendTx(n.PrivateKey, transaction.TypeSend, transaction.SendData{
+		Coin:  types.GetBaseCoin(),
+		To:    types.Address{1},
+		Value: big.NewInt(0).Mul(DefaultBalance, big.NewInt(2)),
+	}); err == nil {
+		t.Fatalf("Transaction with insufficient funds should fail")
+	}
+}
+
+func TestHealth(t *testing.T) {
+	n, err := New(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Stop()
+
+	for _, path := range []string{"/health/live", "/health/ready"} {
+		resp, err := http.Get(n.API1URL() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var report health.Report
+		err = json.NewDecoder(resp.Body).Decode(&report)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != http.StatusOK || !report.Ok {
+			t.Fatalf("Expected %s to be ok, got %d: %+v", path, resp.StatusCode, report.Checks)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.HealthMinPeers = 1
+	report := health.NewChecker(n.App, n.Client, cfg).Ready()
+	if report.Ok {
+		t.Fatal("Node without peers should not be ready")
+	}
+
+	for _, check := range report.Checks {
+		if check.Ok != (check.Name != health.CheckPeers) {
+			t.Fatalf("Wrong result of %s check: %+v", check.Name, check)
+		}
+	}
+}
@@ -36,12 +36,18 @@ func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, cdc *amin
 	}
 
 	// JSONRPC endpoints
-	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, cdc, logger)))
+	jsonRPCHandler := handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, cdc, logger))
+	if middleware != nil {
+		jsonRPCHandler = middleware(jsonRPCHandler)
+	}
+	mux.HandleFunc("/", jsonRPCHandler)
 }
 
 //-------------------------------------
 // function introspection
 
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
 // RPCFunc contains the introspected type information for a function
 type RPCFunc struct {
 	f        reflect.Value  // underlying rpc function
@@ -49,10 +55,12 @@ type RPCFunc struct {
 	returns  []reflect.Type // type of each return arg
 	argNames []string       // name of each argument
 	ws       bool           // websocket only
+	ctx      bool           // first arg is context.Context of the request
 }
 
 // NewRPCFunc wraps a function for introspection.
-// f is the function, args are comma separated argument names
+// f is the function, args are comma separated argument names.
+// If the first argument of f is context.Context, it receives context of the request and has no name in args.
 func NewRPCFunc(f interface{}, args string) *RPCFunc {
 	return newRPCFunc(f, args, false)
 }
@@ -67,12 +75,14 @@ func newRPCFunc(f interface{}, args string, ws bool) *RPCFunc {
 	if args != "" {
 		argNames = strings.Split(args, ",")
 	}
+	argTypes := funcArgTypes(f)
 	return &RPCFunc{
 		f:        reflect.ValueOf(f),
-		args:     funcArgTypes(f),
+		args:     argTypes,
 		returns:  funcReturnTypes(f),
 		argNames: argNames,
 		ws:       ws,
+		ctx:      !ws && len(argTypes) > 0 && argTypes[0] == contextType,
 	}
 }
 
@@ -140,7 +150,7 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, cdc *amino.Codec, logger lo
 		}
 		var args []reflect.Value
 		if len(request.Params) > 0 {
-			args, err = jsonParamsToArgsRPC(rpcFunc, cdc, request.Params)
+			args, err = jsonParamsToArgsRPC(rpcFunc, cdc, request.Params, r.Context())
 			if err != nil {
 				WriteRPCResponseHTTP(w, types.RPCInvalidParamsError(request.ID, errors.Wrap(err, "Error converting json params to arguments")))
 				return
@@ -210,7 +220,8 @@ func arrayParamsToArgs(rpcFunc *RPCFunc, cdc *amino.Codec, params []json.RawMess
 }
 
 // `raw` is unparsed json (from json.RawMessage) encoding either a map or an array.
-// `argsOffset` should be 0 for RPC calls, and 1 for WS requests, where len(rpcFunc.args) != len(rpcFunc.argNames).
+// `argsOffset` should be 0 for RPC calls, and 1 for WS requests and functions accepting context,
+// where len(rpcFunc.args) != len(rpcFunc.argNames).
 //
 // Example:
 //   rpcFunc.args = [rpctypes.WSRPCContext string]
@@ -236,9 +247,18 @@ func jsonParamsToArgs(rpcFunc *RPCFunc, cdc *amino.Codec, raw []byte, argsOffset
 	return nil, errors.Errorf("Unknown type for JSON params: %v. Expected map or array", err)
 }
 
-// Convert a []interface{} OR a map[string]interface{} to properly typed values
-func jsonParamsToArgsRPC(rpcFunc *RPCFunc, cdc *amino.Codec, params json.RawMessage) ([]reflect.Value, error) {
-	return jsonParamsToArgs(rpcFunc, cdc, params, 0)
+// Convert a []interface{} OR a map[string]interface{} to properly typed values,
+// with the first param the context of the request if the function accepts it
+func jsonParamsToArgsRPC(rpcFunc *RPCFunc, cdc *amino.Codec, params json.RawMessage, ctx context.Context) ([]reflect.Value, error) {
+	if !rpcFunc.ctx {
+		return jsonParamsToArgs(rpcFunc, cdc, params, 0)
+	}
+
+	values, err := jsonParamsToArgs(rpcFunc, cdc, params, 1)
+	if err != nil {
+		return nil, err
+	}
+	return append([]reflect.Value{reflect.ValueOf(ctx)}, values...), nil
 }
 
 // Same as above, but with the first param the websocket connection
@@ -286,7 +306,14 @@ func makeHTTPHandler(rpcFunc *RPCFunc, cdc *amino.Codec, logger log.Logger) func
 func httpParamsToArgs(rpcFunc *RPCFunc, cdc *amino.Codec, r *http.Request) ([]reflect.Value, error) {
 	values := make([]reflect.Value, len(rpcFunc.args))
 
+	argsOffset := 0
+	if rpcFunc.ctx {
+		values[0] = reflect.ValueOf(r.Context())
+		argsOffset = 1
+	}
+
 	for i, name := range rpcFunc.argNames {
+		i := i + argsOffset
 		argType := rpcFunc.args[i]
 
 		values[i] = reflect.Zero(argType) // set default for that type
@@ -649,7 +676,7 @@ func (wsc *wsConnection) readRoutine() {
 					args, err = jsonParamsToArgsWS(rpcFunc, wsc.cdc, request.Params, wsCtx)
 				}
 			} else if len(request.Params) > 0 {
-				args, err = jsonParamsToArgsRPC(rpcFunc, wsc.cdc, request.Params)
+				args, err = jsonParamsToArgsRPC(rpcFunc, wsc.cdc, request.Params, context.Background())
 			}
 			if err != nil {
 				wsc.WriteRPCResponse(types.RPCInternalError(request.ID, errors.Wrap(err, "Error converting json params to arguments")))
@@ -2,7 +2,9 @@ package rpcserver_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -29,6 +31,12 @@ import (
 func testMux() *http.ServeMux {
 	funcMap := map[string]*rs.RPCFunc{
 		"c": rs.NewRPCFunc(func(s string, i int) (string, error) { return "foo", nil }, "s,i"),
+		"ctx": rs.NewRPCFunc(func(ctx context.Context, s string) (string, error) {
+			if ctx == nil {
+				return "", errors.New("no context")
+			}
+			return s, nil
+		}, "s"),
 	}
 	cdc := amino.NewCodec()
 	mux := http.NewServeMux()
@@ -92,6 +100,26 @@ func TestRPCParams(t *testing.T) {
 	}
 }
 
+func TestRPCFuncWithContext(t *testing.T) {
+	mux := testMux()
+
+	requests := []*http.Request{
+		httptest.NewRequest("POST", "http://localhost/", strings.NewReader(`{"method": "ctx", "id": "0", "params": ["foo"]}`)),
+		httptest.NewRequest("POST", "http://localhost/", strings.NewReader(`{"method": "ctx", "id": "0", "params": {"s": "foo"}}`)),
+		httptest.NewRequest("GET", "http://localhost/ctx?s=\"foo\"", nil),
+	}
+
+	for i, req := range requests {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		recv := new(types.RPCResponse)
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), recv), "#%d: expecting successful parsing of an RPCResponse", i)
+		require.Nil(t, recv.Error, "#%d: not expecting an error", i)
+		assert.Equal(t, `"foo"`, string(recv.Result), "#%d: unexpected result", i)
+	}
+}
+
 func TestJSONRPCID(t *testing.T) {
 	mux := testMux()
 	tests := []struct {
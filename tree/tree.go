@@ -14,11 +14,13 @@ type Tree interface {
 	LazyLoadVersion(targetVersion int64) (int64, error)
 	SaveVersion() ([]byte, int64, error)
 	DeleteVersion(version int64) error
+	VersionExists(version int64) bool
 	GetImmutable() *ImmutableTree
 	GetImmutableAtHeight(version int64) (*ImmutableTree, error)
 	Version() int64
 	Hash() []byte
 	Iterate(fn func(key []byte, value []byte) bool) (stopped bool)
+	IterateRange(start, end []byte, ascending bool, fn func(key []byte, value []byte) bool) (stopped bool)
 }
 
 func NewMutableTree(db dbm.DB, cacheSize int) *MutableTree {
@@ -52,6 +54,11 @@ func (t *MutableTree) Iterate(fn func(key []byte, value []byte) bool) (stopped b
 	return t.tree.Iterate(fn)
 }
 
+// IterateRange calls fn for keys between start inclusive and end exclusive, nil end means no upper bound
+func (t *MutableTree) IterateRange(start, end []byte, ascending bool, fn func(key []byte, value []byte) bool) (stopped bool) {
+	return t.tree.IterateRange(start, end, ascending, fn)
+}
+
 func (t *MutableTree) Hash() []byte {
 	t.lock.RLock()
 	defer t.lock.RUnlock()
@@ -110,6 +117,14 @@ func (t *MutableTree) LazyLoadVersion(targetVersion int64) (int64, error) {
 	return t.tree.LazyLoadVersion(targetVersion)
 }
 
+// LoadVersionForOverwriting loads the version and deletes all later versions from the database
+func (t *MutableTree) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.tree.LoadVersionForOverwriting(targetVersion)
+}
+
 func (t *MutableTree) SaveVersion() ([]byte, int64, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
@@ -124,6 +139,13 @@ func (t *MutableTree) DeleteVersion(version int64) error {
 	return t.tree.DeleteVersion(version)
 }
 
+func (t *MutableTree) VersionExists(version int64) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.tree.VersionExists(version)
+}
+
 func NewImmutableTree(db dbm.DB) *ImmutableTree {
 	return &ImmutableTree{
 		tree: iavl.NewImmutableTree(db, 1024),
@@ -138,6 +160,11 @@ func (t *ImmutableTree) Iterate(fn func(key []byte, value []byte) bool) (stopped
 	return t.tree.Iterate(fn)
 }
 
+// IterateRange calls fn for keys between start inclusive and end exclusive, nil end means no upper bound
+func (t *ImmutableTree) IterateRange(start, end []byte, ascending bool, fn func(key []byte, value []byte) bool) (stopped bool) {
+	return t.tree.IterateRange(start, end, ascending, fn)
+}
+
 func (t *ImmutableTree) Hash() []byte {
 	return t.tree.Hash()
 }
@@ -181,3 +208,7 @@ func (t *ImmutableTree) SaveVersion() ([]byte, int64, error) {
 func (t *ImmutableTree) DeleteVersion(version int64) error {
 	panic("Not implemented")
 }
+
+func (t *ImmutableTree) VersionExists(version int64) bool {
+	panic("Not implemented")
+}
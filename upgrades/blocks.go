@@ -3,9 +3,10 @@ package upgrades
 const UpgradeBlock1 = 5000
 const UpgradeBlock2 = 38519
 const UpgradeBlock3 = 109000
+const UpgradeBlock4 = 4000000
 
 func IsUpgradeBlock(height uint64) bool {
-	upgradeBlocks := []uint64{UpgradeBlock1, UpgradeBlock2, UpgradeBlock3}
+	upgradeBlocks := []uint64{UpgradeBlock1, UpgradeBlock2, UpgradeBlock3, UpgradeBlock4}
 
 	for _, block := range upgradeBlocks {
 		if height == block {